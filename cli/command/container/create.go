@@ -13,6 +13,7 @@ import (
 	"github.com/docker/cli/cli/command"
 	"github.com/docker/cli/cli/command/completion"
 	"github.com/docker/cli/cli/command/image"
+	"github.com/docker/cli/cli/hints"
 	"github.com/docker/cli/cli/streams"
 	"github.com/docker/cli/opts"
 	"github.com/docker/docker/api/types/container"
@@ -192,6 +193,9 @@ func createContainer(ctx context.Context, dockerCli command.Cli, containerCfg *c
 
 	warnOnOomKillDisable(*hostConfig, dockerCli.Err())
 	warnOnLocalhostDNS(*hostConfig, dockerCli.Err())
+	if !options.quiet && hints.Enabled() {
+		warnOnLegacyLinks(*hostConfig, dockerCli.Err())
+	}
 
 	var (
 		trustedRef reference.Canonical
@@ -299,6 +303,15 @@ func warnOnLocalhostDNS(hostConfig container.HostConfig, stderr io.Writer) {
 	}
 }
 
+// warnOnLegacyLinks warns when using legacy links (--link) on the default
+// network. Links on user-defined networks are not affected, as they are
+// converted to aliases.
+func warnOnLegacyLinks(hostConfig container.HostConfig, stderr io.Writer) {
+	if len(hostConfig.Links) > 0 && (hostConfig.NetworkMode.IsDefault() || hostConfig.NetworkMode.IsBridge()) {
+		fmt.Fprintln(stderr, "WARNING: --link is a legacy feature that only works on the default bridge network; consider using a user-defined network (docker network create) with --network-alias instead.")
+	}
+}
+
 // IPLocalhost is a regex pattern for IPv4 or IPv6 loopback range.
 const ipLocalhost = `((127\.([0-9]{1,3}\.){2}[0-9]{1,3})|(::1)$)`
 
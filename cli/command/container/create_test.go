@@ -281,6 +281,25 @@ func TestNewCreateCommandWithWarnings(t *testing.T) {
 			args:    []string{"--dns=::1", "image:tag"},
 			warning: true,
 		},
+		{
+			name:    "container-create-link-default-network",
+			args:    []string{"--link=db:mysql", "image:tag"},
+			warning: true,
+		},
+		{
+			name:    "container-create-link-bridge-network",
+			args:    []string{"--link=db:mysql", "--network=bridge", "image:tag"},
+			warning: true,
+		},
+		{
+			name: "container-create-link-user-defined-network",
+			args: []string{"--link=db:mysql", "--network=mynet", "image:tag"},
+		},
+		{
+			name:    "container-create-link-quiet",
+			args:    []string{"--link=db:mysql", "--quiet", "image:tag"},
+			warning: true,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -309,6 +328,20 @@ func TestNewCreateCommandWithWarnings(t *testing.T) {
 	}
 }
 
+func TestNewCreateCommandLinkWarningWithHintsDisabled(t *testing.T) {
+	t.Setenv("DOCKER_CLI_HINTS", "false")
+	cli := test.NewFakeCli(&fakeClient{
+		createContainerFunc: func(*container.Config, *container.HostConfig, *network.NetworkingConfig, *specs.Platform, string) (container.CreateResponse, error) {
+			return container.CreateResponse{}, nil
+		},
+	})
+	cmd := NewCreateCommand(cli)
+	cmd.SetOut(io.Discard)
+	cmd.SetArgs([]string{"--link=db:mysql", "image:tag"})
+	assert.NilError(t, cmd.Execute())
+	assert.Equal(t, cli.ErrBuffer().String(), "")
+}
+
 func TestCreateContainerWithProxyConfig(t *testing.T) {
 	expected := []string{
 		"HTTP_PROXY=httpProxy",
@@ -5,12 +5,17 @@ package container
 
 import (
 	"context"
+	"strings"
+	"text/template"
 
 	"github.com/docker/cli/cli"
 	"github.com/docker/cli/cli/command"
 	"github.com/docker/cli/cli/command/completion"
 	"github.com/docker/cli/cli/command/inspect"
 	flagsHelper "github.com/docker/cli/cli/flags"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -48,5 +53,79 @@ func runInspect(ctx context.Context, dockerCli command.Cli, opts inspectOptions)
 	getRefFunc := func(ref string) (any, []byte, error) {
 		return client.ContainerInspectWithRaw(ctx, ref, opts.size)
 	}
-	return inspect.Inspect(dockerCli.Out(), opts.refs, opts.format, getRefFunc)
+	return inspect.InspectWithFuncs(dockerCli.Out(), opts.refs, opts.format, getRefFunc, InspectTemplateFuncs(ctx, client))
+}
+
+// InspectLink is a legacy link (`--link`) of a container, as returned by the
+// "links" template function.
+type InspectLink struct {
+	// Target is the name of the linked container.
+	Target string
+	// Alias is the name under which the linked container is known inside
+	// the container.
+	Alias string
+	// TargetID is the ID of the linked container, or empty if the linked
+	// container could not be resolved.
+	TargetID string
+}
+
+// InspectTemplateFuncs returns the template functions that are available when
+// inspecting containers:
+//
+//   - links: returns the legacy links of the container as a list of
+//     InspectLink. Link targets are resolved to their container ID, and
+//     cached for the lifetime of the returned functions.
+func InspectTemplateFuncs(ctx context.Context, apiClient client.ContainerAPIClient) template.FuncMap {
+	targetIDs := map[string]string{}
+	resolve := func(target string) string {
+		id, ok := targetIDs[target]
+		if !ok {
+			if c, err := apiClient.ContainerInspect(ctx, target); err == nil && c.ContainerJSONBase != nil {
+				id = c.ID
+			}
+			targetIDs[target] = id
+		}
+		return id
+	}
+
+	return template.FuncMap{
+		"links": func(v any) ([]InspectLink, error) {
+			var c types.ContainerJSON
+			switch ctr := v.(type) {
+			case types.ContainerJSON:
+				c = ctr
+			case *types.ContainerJSON:
+				c = *ctr
+			default:
+				return nil, errors.Errorf("links: expected a container, got %T", v)
+			}
+			if c.ContainerJSONBase == nil || c.HostConfig == nil {
+				return nil, nil
+			}
+
+			links := make([]InspectLink, 0, len(c.HostConfig.Links))
+			for _, l := range c.HostConfig.Links {
+				link := parseLink(l)
+				link.TargetID = resolve(link.Target)
+				links = append(links, link)
+			}
+			return links, nil
+		},
+	}
+}
+
+// parseLink parses a link as stored in the container's HostConfig, which is
+// in "/<target>:/<container>/<alias>" form.
+func parseLink(link string) InspectLink {
+	target, alias, ok := strings.Cut(link, ":")
+	if !ok {
+		alias = target
+	}
+	if i := strings.LastIndex(alias, "/"); i >= 0 {
+		alias = alias[i+1:]
+	}
+	return InspectLink{
+		Target: strings.TrimPrefix(target, "/"),
+		Alias:  alias,
+	}
 }
@@ -0,0 +1,121 @@
+package container
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/docker/cli/internal/test"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/errdefs"
+	"github.com/pkg/errors"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+)
+
+func TestParseLink(t *testing.T) {
+	testCases := []struct {
+		link     string
+		expected InspectLink
+	}{
+		{link: "/db1:/app1/mysql", expected: InspectLink{Target: "db1", Alias: "mysql"}},
+		{link: "db1:mysql", expected: InspectLink{Target: "db1", Alias: "mysql"}},
+		{link: "/db1", expected: InspectLink{Target: "db1", Alias: "db1"}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.link, func(t *testing.T) {
+			assert.Check(t, is.DeepEqual(parseLink(tc.link), tc.expected))
+		})
+	}
+}
+
+func TestInspectLinksTemplateFunc(t *testing.T) {
+	var inspected []string
+	cli := test.NewFakeCli(&fakeClient{
+		inspectFunc: func(ref string) (types.ContainerJSON, error) {
+			inspected = append(inspected, ref)
+			switch ref {
+			case "app1":
+				return types.ContainerJSON{
+					ContainerJSONBase: &types.ContainerJSONBase{
+						ID:   "app1-id",
+						Name: "/app1",
+						HostConfig: &container.HostConfig{
+							Links: []string{"/db1:/app1/mysql", "/db1:/app1/db", "/gone:/app1/cache"},
+						},
+					},
+				}, nil
+			case "db1":
+				return types.ContainerJSON{
+					ContainerJSONBase: &types.ContainerJSONBase{ID: "db1-id", Name: "/db1"},
+				}, nil
+			default:
+				return types.ContainerJSON{}, errdefs.NotFound(errors.New("No such container: " + ref))
+			}
+		},
+	})
+	cmd := newInspectCommand(cli)
+	cmd.SetOut(io.Discard)
+	cmd.SetArgs([]string{"--format", "{{range links .}}{{.Alias}}={{.Target}}:{{.TargetID}} {{end}}", "app1"})
+	assert.NilError(t, cmd.Execute())
+	assert.Check(t, is.Equal(cli.OutBuffer().String(), "mysql=db1:db1-id db=db1:db1-id cache=gone: \n"))
+
+	// link targets are only resolved once per invocation
+	assert.Check(t, is.DeepEqual(inspected, []string{"app1", "db1", "gone"}))
+}
+
+func TestInspectLinksTemplateFuncNoLinks(t *testing.T) {
+	cli := test.NewFakeCli(&fakeClient{
+		inspectFunc: func(string) (types.ContainerJSON, error) {
+			return types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					ID:         "db1-id",
+					Name:       "/db1",
+					HostConfig: &container.HostConfig{},
+				},
+			}, nil
+		},
+	})
+	cmd := newInspectCommand(cli)
+	cmd.SetOut(io.Discard)
+	cmd.SetArgs([]string{"--format", "{{len (links .)}}", "db1"})
+	assert.NilError(t, cmd.Execute())
+	assert.Check(t, is.Equal(cli.OutBuffer().String(), "0\n"))
+}
+
+func TestInspectSize(t *testing.T) {
+	for _, tc := range []struct {
+		args         []string
+		expectedSize bool
+	}{
+		{args: []string{"web"}, expectedSize: false},
+		{args: []string{"--size", "web"}, expectedSize: true},
+		{args: []string{"-s", "web"}, expectedSize: true},
+	} {
+		tc := tc
+		t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+			cli := test.NewFakeCli(&fakeClient{
+				inspectWithRawFunc: func(ref string, getSize bool) (types.ContainerJSON, []byte, error) {
+					assert.Check(t, is.Equal(getSize, tc.expectedSize))
+					c := types.ContainerJSON{ContainerJSONBase: &types.ContainerJSONBase{ID: "web-id", Name: "/" + ref}}
+					if getSize {
+						sizeRw, sizeRootFs := int64(10), int64(20)
+						c.SizeRw, c.SizeRootFs = &sizeRw, &sizeRootFs
+					}
+					return c, nil, nil
+				},
+			})
+			cmd := newInspectCommand(cli)
+			cmd.SetOut(io.Discard)
+			cmd.SetArgs(append([]string{"--format", "{{json .SizeRw}} {{json .SizeRootFs}}"}, tc.args...))
+			assert.NilError(t, cmd.Execute())
+			expected := "null null\n"
+			if tc.expectedSize {
+				expected = "10 20\n"
+			}
+			assert.Check(t, is.Equal(cli.OutBuffer().String(), expected))
+		})
+	}
+}
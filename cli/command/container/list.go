@@ -3,6 +3,11 @@ package container
 import (
 	"context"
 	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/docker/cli/cli"
 	"github.com/docker/cli/cli/command"
@@ -11,9 +16,14 @@ import (
 	flagsHelper "github.com/docker/cli/cli/flags"
 	"github.com/docker/cli/opts"
 	"github.com/docker/cli/templates"
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
 type psOptions struct {
@@ -25,6 +35,7 @@ type psOptions struct {
 	nLatest     bool
 	last        int
 	format      string
+	sort        string
 	filter      opts.FilterOpt
 }
 
@@ -57,10 +68,62 @@ func NewPsCommand(dockerCLI command.Cli) *cobra.Command {
 	flags.IntVarP(&options.last, "last", "n", -1, "Show n last created containers (includes all states)")
 	flags.StringVar(&options.format, "format", "", flagsHelper.FormatHelp)
 	flags.VarP(&options.filter, "filter", "f", "Filter output based on conditions provided")
+	flags.StringVar(&options.sort, "sort", "", `Sort output by field ("`+strings.Join(containerSortFields, `", "`)+`"), append ":desc" for descending order`)
+
+	cmd.RegisterFlagCompletionFunc("filter", completeContainerListFilters)
+	cmd.RegisterFlagCompletionFunc("sort", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return containerSortFields, cobra.ShellCompDirectiveNoFileComp
+	})
 
 	return cmd
 }
 
+// containerListFilterKeys are the filter keys offered for completion of the
+// "--filter" flag of `docker ps`.
+var containerListFilterKeys = []string{
+	"ancestor",
+	"before",
+	"exited",
+	"expose",
+	"health",
+	"id",
+	"is-task",
+	"isolation",
+	"label",
+	"name",
+	"name-exact",
+	"network",
+	"publish",
+	"restarts",
+	"since",
+	"status",
+	"volume",
+}
+
+// containerHealthFilterValues are the values offered for completion of the
+// "health" filter of `docker ps`.
+var containerHealthFilterValues = []string{"starting", "healthy", "unhealthy", "none"}
+
+// completeContainerListFilters offers completion for the filter keys of
+// `docker ps --filter`, and for the values of the "health" filter.
+func completeContainerListFilters(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if key, _, ok := strings.Cut(toComplete, "="); ok {
+		if key != "health" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		values := make([]string, 0, len(containerHealthFilterValues))
+		for _, v := range containerHealthFilterValues {
+			values = append(values, key+"="+v)
+		}
+		return values, cobra.ShellCompDirectiveNoFileComp
+	}
+	keys := make([]string, 0, len(containerListFilterKeys))
+	for _, k := range containerListFilterKeys {
+		keys = append(keys, k+"=")
+	}
+	return keys, cobra.ShellCompDirectiveNoSpace | cobra.ShellCompDirectiveNoFileComp
+}
+
 func newListCommand(dockerCLI command.Cli) *cobra.Command {
 	cmd := *NewPsCommand(dockerCLI)
 	cmd.Aliases = []string{"ps", "list"}
@@ -73,13 +136,24 @@ func buildContainerListOptions(options *psOptions) (*container.ListOptions, erro
 		All:     options.all,
 		Limit:   options.last,
 		Size:    options.size,
-		Filters: options.filter.Value(),
+		Filters: withoutRestartsFilters(exactNameFilters(options.filter.Value())),
 	}
 
 	if options.nLatest && options.last == -1 {
 		listOptions.Limit = 1
 	}
 
+	if options.sort != "" {
+		field, _, err := parseContainerSort(options.sort)
+		if err != nil {
+			return nil, err
+		}
+		// sorting by size requires the size to be included in the response.
+		if field == "size" {
+			listOptions.Size = true
+		}
+	}
+
 	// always validate template when `--format` is used, for consistency
 	if len(options.format) > 0 {
 		tmpl, err := templates.NewParse("", options.format)
@@ -114,6 +188,231 @@ func buildContainerListOptions(options *psOptions) (*container.ListOptions, erro
 	return listOptions, nil
 }
 
+// containerSortFields are the fields by which containers can be sorted by
+// the "--sort" flag of `docker ps`.
+var containerSortFields = []string{"created", "image", "names", "size", "status"}
+
+// parseContainerSort parses the value of the "--sort" flag, which is formatted
+// as "<field>[:asc|:desc]".
+func parseContainerSort(value string) (field string, desc bool, _ error) {
+	field, order, hasOrder := strings.Cut(value, ":")
+	field = strings.ToLower(strings.TrimSpace(field))
+	if hasOrder {
+		switch strings.ToLower(order) {
+		case "asc":
+		case "desc":
+			desc = true
+		default:
+			return "", false, errors.Errorf("invalid sort order %q: must be one of \"asc\" or \"desc\"", order)
+		}
+	}
+	for _, f := range containerSortFields {
+		if f == field {
+			return field, desc, nil
+		}
+	}
+	return "", false, errors.Errorf("invalid sort field %q: must be one of %s", field, strings.Join(containerSortFields, ", "))
+}
+
+// sortContainers sorts containers by the given field. Containers for which
+// the field is equal are ordered by ID, which is not affected by desc.
+func sortContainers(containers []types.Container, field string, desc bool) {
+	var compare func(a, b types.Container) int
+	switch field {
+	case "created":
+		compare = func(a, b types.Container) int { return cmpOrdered(a.Created, b.Created) }
+	case "image":
+		compare = func(a, b types.Container) int { return strings.Compare(a.Image, b.Image) }
+	case "names":
+		compare = func(a, b types.Container) int { return strings.Compare(primaryName(a), primaryName(b)) }
+	case "size":
+		compare = func(a, b types.Container) int { return cmpOrdered(a.SizeRw, b.SizeRw) }
+	case "status":
+		compare = func(a, b types.Container) int { return strings.Compare(a.State, b.State) }
+	default:
+		return
+	}
+	sort.SliceStable(containers, func(i, j int) bool {
+		c := compare(containers[i], containers[j])
+		if desc {
+			c = -c
+		}
+		if c != 0 {
+			return c < 0
+		}
+		return containers[i].ID < containers[j].ID
+	})
+}
+
+func cmpOrdered(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// primaryName returns the container's name, excluding names for legacy links.
+func primaryName(c types.Container) string {
+	for _, name := range c.Names {
+		name = strings.TrimPrefix(name, "/")
+		if !strings.Contains(name, "/") {
+			return name
+		}
+	}
+	return ""
+}
+
+// exactNameFilters converts "name-exact" filters to anchored "name" filters.
+//
+// The daemon's "name" filter is a regular expression matched against the
+// container's name (including the leading slash), so "name=app" also matches
+// "app2" and "my-app-backup". Converting "name-exact=app" to "name=^/app$" on
+// the client side provides an exact match that works with any daemon version.
+func exactNameFilters(f filters.Args) filters.Args {
+	if !f.Contains("name-exact") {
+		return f
+	}
+	f = f.Clone()
+	for _, name := range f.Get("name-exact") {
+		f.Del("name-exact", name)
+		f.Add("name", "^/"+regexp.QuoteMeta(strings.TrimPrefix(name, "/"))+"$")
+	}
+	return f
+}
+
+// restartsFilterKeys are the keys of the "restarts" filter, which is applied
+// on the client side. "restarts=N" matches containers that were restarted
+// exactly N times, and "restarts=>N" or "restarts=>=N" matches containers
+// that were restarted more than, or at least N times. The filter option
+// splits "restarts>=N" at the first "=", so that form is parsed as a
+// "restarts>" key with value "N".
+var restartsFilterKeys = []string{"restarts", "restarts>"}
+
+// withoutRestartsFilters removes the client-side "restarts" filters, which
+// are not supported by the daemon.
+func withoutRestartsFilters(f filters.Args) filters.Args {
+	if !f.Contains("restarts") && !f.Contains("restarts>") {
+		return f
+	}
+	f = f.Clone()
+	for _, key := range restartsFilterKeys {
+		for _, value := range f.Get(key) {
+			f.Del(key, value)
+		}
+	}
+	return f
+}
+
+// parseRestartsFilters returns a function to match the restart count of a
+// container for each "restarts" filter.
+func parseRestartsFilters(f filters.Args) ([]func(restartCount int) bool, error) {
+	var matchers []func(int) bool
+	for _, key := range restartsFilterKeys {
+		for _, value := range f.Get(key) {
+			match, err := parseRestartsFilter(key, value)
+			if err != nil {
+				return nil, err
+			}
+			matchers = append(matchers, match)
+		}
+	}
+	return matchers, nil
+}
+
+func parseRestartsFilter(key, value string) (func(restartCount int) bool, error) {
+	op, n := "=", value
+	switch {
+	case key == "restarts>":
+		op = ">="
+	case strings.HasPrefix(value, ">="):
+		op, n = ">=", strings.TrimPrefix(value, ">=")
+	case strings.HasPrefix(value, ">"):
+		op, n = ">", strings.TrimPrefix(value, ">")
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(n))
+	if err != nil || count < 0 {
+		return nil, errors.Errorf("invalid filter '%s=%s': restart count must be a non-negative integer", key, value)
+	}
+	switch op {
+	case ">=":
+		return func(restartCount int) bool { return restartCount >= count }, nil
+	case ">":
+		return func(restartCount int) bool { return restartCount > count }, nil
+	default:
+		return func(restartCount int) bool { return restartCount == count }, nil
+	}
+}
+
+// usesRestartCount returns whether the given format explicitly uses the
+// .RestartCount field. Getting the restart count requires inspecting each
+// container, so it's not fetched for the JSON format ("json" or "{{json .}}"),
+// which has an empty RestartCount instead.
+func usesRestartCount(format formatter.Format) bool {
+	return !format.IsJSON() && format.Contains(".RestartCount")
+}
+
+// maxConcurrentInspects is the maximum number of containers that are
+// inspected concurrently to get their restart count.
+const maxConcurrentInspects = 10
+
+// containerRestartCounts inspects the given containers to get their restart
+// count, which is not included in the container list returned by the API.
+// Containers that can no longer be inspected (for example, because they
+// were removed) are omitted.
+func containerRestartCounts(ctx context.Context, apiClient client.ContainerAPIClient, containers []types.Container) (map[string]int, error) {
+	var mu sync.Mutex
+	restartCounts := make(map[string]int, len(containers))
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(maxConcurrentInspects)
+	for _, c := range containers {
+		id := c.ID
+		eg.Go(func() error {
+			ctr, err := apiClient.ContainerInspect(ctx, id)
+			if err != nil {
+				if errdefs.IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			restartCounts[id] = ctr.RestartCount
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return restartCounts, nil
+}
+
+// filterByRestartCount returns the containers for which the restart count
+// matches all the given matchers.
+func filterByRestartCount(containers []types.Container, restartCounts map[string]int, matchers []func(int) bool) []types.Container {
+	filtered := containers[:0]
+	for _, c := range containers {
+		restartCount, ok := restartCounts[c.ID]
+		if !ok {
+			continue
+		}
+		matched := true
+		for _, match := range matchers {
+			if !match(restartCount) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
 func runPs(ctx context.Context, dockerCLI command.Cli, options *psOptions) error {
 	if len(options.format) == 0 {
 		// load custom psFormat from CLI config (if any)
@@ -127,15 +426,41 @@ func runPs(ctx context.Context, dockerCLI command.Cli, options *psOptions) error
 		return err
 	}
 
+	restartsMatchers, err := parseRestartsFilters(options.filter.Value())
+	if err != nil {
+		return err
+	}
+
 	containers, err := dockerCLI.Client().ContainerList(ctx, *listOptions)
 	if err != nil {
 		return err
 	}
 
+	format := formatter.NewContainerFormat(options.format, options.quiet, listOptions.Size)
+
+	var restartCounts map[string]int
+	if len(restartsMatchers) > 0 || (!options.quiet && usesRestartCount(format)) {
+		restartCounts, err = containerRestartCounts(ctx, dockerCLI.Client(), containers)
+		if err != nil {
+			return err
+		}
+		if len(restartsMatchers) > 0 {
+			containers = filterByRestartCount(containers, restartCounts, restartsMatchers)
+		}
+	}
+
+	if options.sort != "" {
+		field, desc, err := parseContainerSort(options.sort)
+		if err != nil {
+			return err
+		}
+		sortContainers(containers, field, desc)
+	}
+
 	containerCtx := formatter.Context{
 		Output: dockerCLI.Out(),
-		Format: formatter.NewContainerFormat(options.format, options.quiet, listOptions.Size),
+		Format: format,
 		Trunc:  !options.noTrunc,
 	}
-	return formatter.ContainerWrite(containerCtx, containers)
+	return formatter.ContainerWriteWithRestartCounts(containerCtx, containers, restartCounts)
 }
@@ -3,6 +3,9 @@ package container
 import (
 	"fmt"
 	"io"
+	"regexp"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/docker/cli/cli/config/configfile"
@@ -11,6 +14,8 @@ import (
 	"github.com/docker/cli/opts"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/errdefs"
+	"github.com/spf13/cobra"
 	"gotest.tools/v3/assert"
 	is "gotest.tools/v3/assert/cmp"
 	"gotest.tools/v3/golden"
@@ -126,6 +131,83 @@ func TestContainerListBuildContainerListOptions(t *testing.T) {
 	}
 }
 
+func TestContainerListExactNameFilter(t *testing.T) {
+	filters := opts.NewFilterOpt()
+	assert.NilError(t, filters.Set("name-exact=app"))
+	assert.NilError(t, filters.Set("name-exact=/my.app"))
+	assert.NilError(t, filters.Set("status=running"))
+
+	options, err := buildContainerListOptions(&psOptions{filter: filters})
+	assert.NilError(t, err)
+
+	assert.Check(t, !options.Filters.Contains("name-exact"))
+	assert.Check(t, is.DeepEqual(options.Filters.Get("status"), []string{"running"}))
+	names := options.Filters.Get("name")
+	sort.Strings(names)
+	assert.Check(t, is.DeepEqual(names, []string{`^/app$`, `^/my\.app$`}))
+
+	// The original filter options must not be modified.
+	assert.Check(t, filters.Value().Contains("name-exact"))
+	assert.Check(t, !filters.Value().Contains("name"))
+
+	appFilter := regexp.MustCompile(`^/app$`)
+	for name, expected := range map[string]bool{
+		"/app":           true,
+		"/app2":          false,
+		"/my-app-backup": false,
+		"/myapp":         false,
+	} {
+		assert.Check(t, is.Equal(appFilter.MatchString(name), expected), name)
+	}
+}
+
+func TestContainerListFilterCompletion(t *testing.T) {
+	keys, directive := completeContainerListFilters(nil, nil, "")
+	assert.Check(t, is.DeepEqual(keys, []string{
+		"ancestor=",
+		"before=",
+		"exited=",
+		"expose=",
+		"health=",
+		"id=",
+		"is-task=",
+		"isolation=",
+		"label=",
+		"name=",
+		"name-exact=",
+		"network=",
+		"publish=",
+		"restarts=",
+		"since=",
+		"status=",
+		"volume=",
+	}))
+	assert.Check(t, is.Equal(directive, cobra.ShellCompDirectiveNoSpace|cobra.ShellCompDirectiveNoFileComp))
+
+	keys, directive = completeContainerListFilters(nil, nil, "name-exact=")
+	assert.Check(t, is.Len(keys, 0))
+	assert.Check(t, is.Equal(directive, cobra.ShellCompDirectiveNoFileComp))
+
+	keys, directive = completeContainerListFilters(nil, nil, "health=")
+	assert.Check(t, is.DeepEqual(keys, []string{"health=starting", "health=healthy", "health=unhealthy", "health=none"}))
+	assert.Check(t, is.Equal(directive, cobra.ShellCompDirectiveNoFileComp))
+}
+
+func TestContainerListHealthFilter(t *testing.T) {
+	cli := test.NewFakeCli(&fakeClient{
+		containerListFunc: func(options container.ListOptions) ([]types.Container, error) {
+			assert.Check(t, is.DeepEqual(options.Filters.Get("health"), []string{"unhealthy"}))
+			return []types.Container{
+				*builders.Container("c1", builders.WithContainerStatus("Up 2 minutes (unhealthy)")),
+			}, nil
+		},
+	})
+	cmd := newListCommand(cli)
+	cmd.SetArgs([]string{"--filter", "health=unhealthy", "--format", "{{.Names}} {{.Health}}"})
+	assert.NilError(t, cmd.Execute())
+	assert.Check(t, is.Equal(cli.OutBuffer().String(), "c1 unhealthy\n"))
+}
+
 func TestContainerListErrors(t *testing.T) {
 	testCases := []struct {
 		args              []string
@@ -329,3 +411,183 @@ func TestContainerListWithFormat(t *testing.T) {
 		golden.Assert(t, cli.OutBuffer().String(), "container-list-quiet.golden")
 	})
 }
+
+func TestContainerListSort(t *testing.T) {
+	containers := func() []types.Container {
+		return []types.Container{
+			{ID: "c3", Names: []string{"/web"}, Image: "nginx", State: "running", Created: 300, SizeRw: 10},
+			{ID: "c1", Names: []string{"/db", "/web/mysql"}, Image: "mysql", State: "exited", Created: 100, SizeRw: 30},
+			{ID: "c4", Names: []string{"/cache"}, Image: "redis", State: "running", Created: 200, SizeRw: 10},
+			{ID: "c2", Names: []string{"/api"}, Image: "nginx", State: "created", Created: 200, SizeRw: 20},
+		}
+	}
+	testCases := []struct {
+		sort     string
+		expected string
+	}{
+		{sort: "names", expected: "api cache db web"},
+		{sort: "names:desc", expected: "web db cache api"},
+		{sort: "created", expected: "db api cache web"},
+		{sort: "created:asc", expected: "db api cache web"},
+		// ties are ordered by ID, also when sorting in descending order.
+		{sort: "created:desc", expected: "web api cache db"},
+		{sort: "status", expected: "api db web cache"},
+		{sort: "status:desc", expected: "web cache db api"},
+		{sort: "image", expected: "db api web cache"},
+		{sort: "image:DESC", expected: "cache api web db"},
+		{sort: "size", expected: "web cache api db"},
+		{sort: "size:desc", expected: "db api web cache"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.sort, func(t *testing.T) {
+			var listOptions container.ListOptions
+			cli := test.NewFakeCli(&fakeClient{
+				containerListFunc: func(options container.ListOptions) ([]types.Container, error) {
+					listOptions = options
+					return containers(), nil
+				},
+			})
+			cmd := newListCommand(cli)
+			cmd.SetArgs([]string{"--sort", tc.sort, "--format", "{{.Names}}"})
+			assert.NilError(t, cmd.Execute())
+			assert.Check(t, is.Equal(strings.Join(strings.Fields(cli.OutBuffer().String()), " "), tc.expected))
+			assert.Check(t, is.Equal(listOptions.Size, strings.HasPrefix(tc.sort, "size")))
+		})
+	}
+}
+
+func TestContainerListSortErrors(t *testing.T) {
+	testCases := []struct {
+		sort          string
+		expectedError string
+	}{
+		{sort: "id", expectedError: `invalid sort field "id": must be one of created, image, names, size, status`},
+		{sort: "names:up", expectedError: `invalid sort order "up": must be one of "asc" or "desc"`},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.sort, func(t *testing.T) {
+			cli := test.NewFakeCli(&fakeClient{
+				containerListFunc: func(container.ListOptions) ([]types.Container, error) {
+					t.Fatal("containers should not be listed with an invalid --sort")
+					return nil, nil
+				},
+			})
+			cmd := newListCommand(cli)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			cmd.SetArgs([]string{"--sort", tc.sort})
+			assert.Error(t, cmd.Execute(), tc.expectedError)
+		})
+	}
+}
+
+func TestParseRestartsFilter(t *testing.T) {
+	for _, tc := range []struct {
+		key, value string
+		matches    []int
+		noMatches  []int
+	}{
+		{key: "restarts", value: "3", matches: []int{3}, noMatches: []int{0, 2, 4}},
+		{key: "restarts", value: ">3", matches: []int{4, 10}, noMatches: []int{0, 3}},
+		{key: "restarts", value: ">=3", matches: []int{3, 4}, noMatches: []int{0, 2}},
+		{key: "restarts>", value: "3", matches: []int{3, 4}, noMatches: []int{0, 2}},
+	} {
+		match, err := parseRestartsFilter(tc.key, tc.value)
+		assert.NilError(t, err)
+		for _, n := range tc.matches {
+			assert.Check(t, match(n), "%s=%s should match %d", tc.key, tc.value, n)
+		}
+		for _, n := range tc.noMatches {
+			assert.Check(t, !match(n), "%s=%s should not match %d", tc.key, tc.value, n)
+		}
+	}
+
+	for _, value := range []string{"", "many", ">", ">=-1", "<3"} {
+		_, err := parseRestartsFilter("restarts", value)
+		assert.Check(t, is.ErrorContains(err, "restart count must be a non-negative integer"), value)
+	}
+}
+
+func TestContainerListRestartsFilter(t *testing.T) {
+	restartCounts := map[string]int{"id1": 0, "id2": 3, "id3": 7}
+	fakeCli := test.NewFakeCli(&fakeClient{
+		containerListFunc: func(options container.ListOptions) ([]types.Container, error) {
+			assert.Check(t, !options.Filters.Contains("restarts"))
+			assert.Check(t, !options.Filters.Contains("restarts>"))
+			assert.Check(t, is.DeepEqual(options.Filters.Get("status"), []string{"restarting"}))
+			return []types.Container{
+				{ID: "id1", Names: []string{"/c1"}},
+				{ID: "id2", Names: []string{"/c2"}},
+				{ID: "id3", Names: []string{"/c3"}},
+				{ID: "id4", Names: []string{"/removed"}},
+			}, nil
+		},
+		inspectFunc: func(id string) (types.ContainerJSON, error) {
+			restartCount, ok := restartCounts[id]
+			if !ok {
+				return types.ContainerJSON{}, errdefs.NotFound(fmt.Errorf("no such container: %s", id))
+			}
+			return types.ContainerJSON{ContainerJSONBase: &types.ContainerJSONBase{ID: id, RestartCount: restartCount}}, nil
+		},
+	})
+	cmd := newListCommand(fakeCli)
+	cmd.SetArgs([]string{"--filter", "status=restarting", "--filter", "restarts>=3", "--format", "{{.Names}} {{.RestartCount}}"})
+	assert.NilError(t, cmd.Execute())
+	assert.Check(t, is.Equal(fakeCli.OutBuffer().String(), "c2 3\nc3 7\n"))
+}
+
+func TestContainerListRestartCountFormat(t *testing.T) {
+	var inspected []string
+	fakeCli := test.NewFakeCli(&fakeClient{
+		containerListFunc: func(container.ListOptions) ([]types.Container, error) {
+			return []types.Container{{ID: "id1", Names: []string{"/c1"}}}, nil
+		},
+		inspectFunc: func(id string) (types.ContainerJSON, error) {
+			inspected = append(inspected, id)
+			return types.ContainerJSON{ContainerJSONBase: &types.ContainerJSONBase{ID: id, RestartCount: 2}}, nil
+		},
+	})
+
+	cmd := newListCommand(fakeCli)
+	cmd.SetArgs([]string{"--format", "{{.Names}}"})
+	assert.NilError(t, cmd.Execute())
+	assert.Check(t, is.Len(inspected, 0), "containers should only be inspected if .RestartCount is used")
+
+	fakeCli.OutBuffer().Reset()
+	cmd = newListCommand(fakeCli)
+	cmd.SetArgs([]string{"--format", "table {{.Names}}\t{{.RestartCount}}"})
+	assert.NilError(t, cmd.Execute())
+	assert.Check(t, is.DeepEqual(inspected, []string{"id1"}))
+	assert.Check(t, is.Equal(fakeCli.OutBuffer().String(), "NAMES     RESTARTS\nc1        2\n"))
+}
+
+func TestContainerListRestartCountJSONFormat(t *testing.T) {
+	for _, format := range []string{"json", "{{json .}}"} {
+		t.Run(format, func(t *testing.T) {
+			fakeCli := test.NewFakeCli(&fakeClient{
+				containerListFunc: func(container.ListOptions) ([]types.Container, error) {
+					return []types.Container{{ID: "id1", Names: []string{"/c1"}}}, nil
+				},
+				inspectFunc: func(string) (types.ContainerJSON, error) {
+					t.Error("containers should not be inspected for the JSON format")
+					return types.ContainerJSON{}, nil
+				},
+			})
+			cmd := newListCommand(fakeCli)
+			cmd.SetArgs([]string{"--format", format})
+			assert.NilError(t, cmd.Execute())
+			assert.Check(t, is.Contains(fakeCli.OutBuffer().String(), `"RestartCount":""`))
+		})
+	}
+}
+
+func TestContainerListRestartsFilterInvalid(t *testing.T) {
+	fakeCli := test.NewFakeCli(&fakeClient{})
+	cmd := newListCommand(fakeCli)
+	cmd.SetArgs([]string{"--filter", "restarts=lots"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	assert.ErrorContains(t, cmd.Execute(), "invalid filter 'restarts=lots'")
+}
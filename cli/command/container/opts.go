@@ -180,7 +180,7 @@ func addFlags(flags *pflag.FlagSet) *containerOptions {
 		ulimits:           opts.NewUlimitOpt(nil),
 		volumes:           opts.NewListOpts(nil),
 		volumesFrom:       opts.NewListOpts(nil),
-		annotations:       opts.NewMapOpts(nil, nil),
+		annotations:       opts.NewMapOpts(nil, opts.ValidateAnnotation),
 	}
 
 	// General purpose flags
@@ -831,7 +831,9 @@ func applyContainerOptions(n *opts.NetworkAttachmentOpts, copts *containerOption
 		n.Aliases = make([]string, copts.aliases.Len())
 		copy(n.Aliases, copts.aliases.GetAll())
 	}
-	if n.Target != "default" && copts.links.Len() > 0 {
+	// Legacy links on the default bridge network are set through
+	// HostConfig.Links, and are not supported as per-network links.
+	if nm := container.NetworkMode(n.Target); !nm.IsDefault() && !nm.IsBridge() && copts.links.Len() > 0 {
 		n.Links = make([]string, copts.links.Len())
 		copy(n.Links, copts.links.GetAll())
 	}
@@ -75,6 +75,9 @@ func TestParseRunLinks(t *testing.T) {
 	if _, hostConfig, _ := mustParse(t, "--link a:b"); len(hostConfig.Links) == 0 || hostConfig.Links[0] != "a:b" {
 		t.Fatalf("Error parsing links. Expected []string{\"a:b\"}, received: %v", hostConfig.Links)
 	}
+	if _, hostConfig, nwConfig := mustParse(t, "--link a:b --network=bridge"); len(hostConfig.Links) == 0 || hostConfig.Links[0] != "a:b" || nwConfig.EndpointsConfig["bridge"] != nil {
+		t.Fatalf("Error parsing links on the bridge network. Expected []string{\"a:b\"} in HostConfig.Links only, received: %v", hostConfig.Links)
+	}
 	if _, hostConfig, _ := mustParse(t, "--link a:b --link c:d"); len(hostConfig.Links) < 2 || hostConfig.Links[0] != "a:b" || hostConfig.Links[1] != "c:d" {
 		t.Fatalf("Error parsing links. Expected []string{\"a:b\", \"c:d\"}, received: %v", hostConfig.Links)
 	}
@@ -83,6 +86,15 @@ func TestParseRunLinks(t *testing.T) {
 	}
 }
 
+func TestParseRunLinksNonUserDefinedNetwork(t *testing.T) {
+	for _, network := range []string{"host", "none", "container:abc123"} {
+		t.Run(network, func(t *testing.T) {
+			_, _, _, err := parseRun([]string{"--link=a:b", "--network=" + network, "ubuntu", "bash"})
+			assert.Error(t, err, "links are only supported for user-defined networks")
+		})
+	}
+}
+
 func TestParseRunAttach(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -311,6 +323,16 @@ func TestRunFlagsParseWithMemory(t *testing.T) {
 	assert.Check(t, is.Equal(int64(1073741824), hostconfig.Memory))
 }
 
+func TestParseWithAnnotations(t *testing.T) {
+	flags, _ := setupRunFlags()
+	args := []string{"--annotation=value", "--annotation==value", "img", "cmd"}
+	err := flags.Parse(args)
+	assert.ErrorContains(t, err, `invalid argument "=value" for "--annotation" flag: invalid annotation '=value': empty name`)
+
+	_, hostconfig, _ := mustParse(t, "--annotation=com.example.one=1 --annotation=com.example.two")
+	assert.Check(t, is.DeepEqual(hostconfig.Annotations, map[string]string{"com.example.one": "1", "com.example.two": ""}))
+}
+
 func TestParseWithMemorySwap(t *testing.T) {
 	flags, _ := setupRunFlags()
 	args := []string{"--memory-swap=invalid", "img", "cmd"}
@@ -3,17 +3,21 @@ package container
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/docker/cli/cli"
 	"github.com/docker/cli/cli/command"
 	"github.com/docker/cli/cli/command/completion"
 	"github.com/docker/cli/opts"
+	"github.com/docker/docker/api/types/filters"
 	units "github.com/docker/go-units"
 	"github.com/spf13/cobra"
 )
 
 type pruneOptions struct {
 	force  bool
+	yes    bool
 	filter opts.FilterOpt
 }
 
@@ -42,6 +46,7 @@ func NewPruneCommand(dockerCli command.Cli) *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.BoolVarP(&options.force, "force", "f", false, "Do not prompt for confirmation")
+	flags.BoolVar(&options.yes, "yes", false, yesHelp)
 	flags.Var(&options.filter, "filter", `Provide filter values (e.g. "until=<timestamp>")`)
 
 	return cmd
@@ -50,13 +55,42 @@ func NewPruneCommand(dockerCli command.Cli) *cobra.Command {
 const warning = `WARNING! This will remove all stopped containers.
 Are you sure you want to continue?`
 
+const nameFilterWarning = `WARNING! This will remove all stopped containers with a name matching:
+%s
+Are you sure you want to continue?`
+
+// pruneWarning returns the confirmation message to print for the given
+// filters. If the filters contain name patterns, the patterns are included
+// in the message so that users are aware of which containers are affected.
+func pruneWarning(pruneFilters filters.Args) string {
+	names := pruneFilters.Get("name")
+	if len(names) == 0 {
+		return warning
+	}
+	sort.Strings(names)
+	var patterns strings.Builder
+	for _, name := range names {
+		patterns.WriteString("  - " + name + "\n")
+	}
+	return fmt.Sprintf(nameFilterWarning, strings.TrimSuffix(patterns.String(), "\n"))
+}
+
 func runPrune(ctx context.Context, dockerCli command.Cli, options pruneOptions) (spaceReclaimed uint64, output string, err error) {
 	pruneFilters := command.PruneFilters(dockerCli, options.filter.Value())
 
-	if !options.force && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), warning) {
+	// Protected contexts require confirmation, even if --force is set.
+	if !options.yes && command.IsProtectedContext(dockerCli) {
+		if !command.ConfirmProtectedContext(dockerCli, pruneWarning(pruneFilters)) {
+			return 0, "", nil
+		}
+	} else if !options.force && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), pruneWarning(pruneFilters)) {
 		return 0, "", nil
 	}
 
+	return prune(ctx, dockerCli, pruneFilters)
+}
+
+func prune(ctx context.Context, dockerCli command.Cli, pruneFilters filters.Args) (spaceReclaimed uint64, output string, err error) {
 	report, err := dockerCli.Client().ContainersPrune(ctx, pruneFilters)
 	if err != nil {
 		return 0, "", err
@@ -74,7 +108,9 @@ func runPrune(ctx context.Context, dockerCli command.Cli, options pruneOptions)
 }
 
 // RunPrune calls the Container Prune API
-// This returns the amount of space reclaimed and a detailed output string
+// This returns the amount of space reclaimed and a detailed output string.
+// It does not prompt for confirmation; callers must confirm the operation,
+// including for protected contexts.
 func RunPrune(ctx context.Context, dockerCli command.Cli, _ bool, filter opts.FilterOpt) (uint64, string, error) {
-	return runPrune(ctx, dockerCli, pruneOptions{force: true, filter: filter})
+	return prune(ctx, dockerCli, command.PruneFilters(dockerCli, filter.Value()))
 }
@@ -0,0 +1,156 @@
+package container
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/docker/cli/cli/streams"
+	"github.com/docker/cli/internal/test"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+)
+
+func TestContainerPrunePromptNameFilter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name: "no filters",
+			args: []string{},
+			expected: `WARNING! This will remove all stopped containers.
+Are you sure you want to continue? [y/N] Total reclaimed space: 0B
+`,
+		},
+		{
+			name: "non-name filter",
+			args: []string{"--filter", "until=24h"},
+			expected: `WARNING! This will remove all stopped containers.
+Are you sure you want to continue? [y/N] Total reclaimed space: 0B
+`,
+		},
+		{
+			name: "name filters",
+			args: []string{"--filter", "name=test-*", "--filter", "name=ci-*", "--filter", "until=24h"},
+			expected: `WARNING! This will remove all stopped containers with a name matching:
+  - ci-*
+  - test-*
+Are you sure you want to continue? [y/N] Total reclaimed space: 0B
+`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cli := test.NewFakeCli(&fakeClient{
+				containerPruneFunc: func(context.Context, filters.Args) (types.ContainersPruneReport, error) {
+					t.Fatal("prune should not be called without confirmation")
+					return types.ContainersPruneReport{}, nil
+				},
+			})
+			cmd := NewPruneCommand(cli)
+			cmd.SetOut(io.Discard)
+			cmd.SetArgs(tc.args)
+			assert.NilError(t, cmd.Execute())
+			assert.Check(t, is.Equal(cli.OutBuffer().String(), tc.expected))
+		})
+	}
+}
+
+func TestContainerPruneNameFilter(t *testing.T) {
+	var pruneFilters filters.Args
+	cli := test.NewFakeCli(&fakeClient{
+		containerPruneFunc: func(_ context.Context, f filters.Args) (types.ContainersPruneReport, error) {
+			pruneFilters = f
+			return types.ContainersPruneReport{
+				ContainersDeleted: []string{"abc123"},
+				SpaceReclaimed:    1024,
+			}, nil
+		},
+	})
+	cmd := NewPruneCommand(cli)
+	cmd.SetOut(io.Discard)
+	cmd.SetArgs([]string{"--force", "--filter", "name=ci-*"})
+	assert.NilError(t, cmd.Execute())
+
+	assert.Check(t, is.DeepEqual(pruneFilters.Get("name"), []string{"ci-*"}))
+	assert.Check(t, is.Equal(cli.OutBuffer().String(), "Deleted Containers:\nabc123\n\nTotal reclaimed space: 1.024kB\n"))
+}
+
+func TestContainerPruneProtectedContext(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		args           []string
+		input          string
+		expectedPrune  bool
+		expectedPrompt bool
+	}{
+		{
+			name:           "force still prompts",
+			args:           []string{"--force"},
+			input:          "n\n",
+			expectedPrompt: true,
+		},
+		{
+			name:           "confirmed",
+			args:           []string{"--force"},
+			input:          "y\n",
+			expectedPrompt: true,
+			expectedPrune:  true,
+		},
+		{
+			name:          "with --yes",
+			args:          []string{"--force", "--yes"},
+			expectedPrune: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var pruned bool
+			fakeCli := test.NewFakeCli(&fakeClient{
+				containerPruneFunc: func(context.Context, filters.Args) (types.ContainersPruneReport, error) {
+					pruned = true
+					return types.ContainersPruneReport{}, nil
+				},
+			})
+			setContext(t, fakeCli, true)
+			fakeCli.SetIn(streams.NewIn(io.NopCloser(strings.NewReader(tc.input))))
+			cmd := NewPruneCommand(fakeCli)
+			cmd.SetOut(io.Discard)
+			cmd.SetArgs(tc.args)
+			assert.NilError(t, cmd.Execute())
+			assert.Check(t, is.Equal(pruned, tc.expectedPrune))
+
+			const prompt = `WARNING! Context "production" is protected.
+This will remove all stopped containers.
+Are you sure you want to continue? [y/N] `
+			assert.Check(t, is.Equal(strings.HasPrefix(fakeCli.OutBuffer().String(), prompt), tc.expectedPrompt))
+		})
+	}
+}
+
+func TestContainerPruneProtectedContextNameFilter(t *testing.T) {
+	fakeCli := test.NewFakeCli(&fakeClient{
+		containerPruneFunc: func(context.Context, filters.Args) (types.ContainersPruneReport, error) {
+			t.Fatal("prune should not be called without confirmation")
+			return types.ContainersPruneReport{}, nil
+		},
+	})
+	setContext(t, fakeCli, true)
+	fakeCli.SetIn(streams.NewIn(io.NopCloser(strings.NewReader("n\n"))))
+	cmd := NewPruneCommand(fakeCli)
+	cmd.SetOut(io.Discard)
+	cmd.SetArgs([]string{"--force", "--filter", "name=ci-*"})
+	assert.NilError(t, cmd.Execute())
+	expected := `WARNING! Context "production" is protected.
+This will remove all stopped containers with a name matching:
+  - ci-*
+Are you sure you want to continue? [y/N] Total reclaimed space: 0B
+`
+	assert.Check(t, is.Equal(fakeCli.OutBuffer().String(), expected))
+}
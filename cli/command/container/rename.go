@@ -1,46 +1,282 @@
 package container
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"regexp"
 	"strings"
+	"time"
 
+	"github.com/distribution/reference"
 	"github.com/docker/cli/cli"
 	"github.com/docker/cli/cli/command"
 	"github.com/docker/cli/cli/command/completion"
+	"github.com/docker/cli/cli/command/formatter"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/stringid"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
-type renameOptions struct {
+// validContainerName matches the names accepted by the daemon for containers.
+var validContainerName = regexp.MustCompile(`^/?[a-zA-Z0-9][a-zA-Z0-9_.-]+$`)
+
+type renamePair struct {
 	oldName string
 	newName string
 }
 
+type renameOptions struct {
+	pairs    []renamePair
+	fromFile string
+	dryRun   bool
+	autoName bool
+
+	strictExitCodes bool
+}
+
 // NewRenameCommand creates a new cobra.Command for `docker rename`
 func NewRenameCommand(dockerCli command.Cli) *cobra.Command {
 	var opts renameOptions
 
 	cmd := &cobra.Command{
-		Use:   "rename CONTAINER NEW_NAME",
+		// Multiple CONTAINER NEW_NAME pairs are accepted, but are not
+		// included in the usage, to keep the usage and errors for the
+		// common case of renaming a single container unchanged.
+		Use:   "rename [OPTIONS] CONTAINER NEW_NAME",
 		Short: "Rename a container",
-		Args:  cli.ExactArgs(2),
+		Args:  requiresRenamePairs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.oldName = args[0]
-			opts.newName = args[1]
+			if opts.fromFile != "" {
+				pairs, err := readRenamePairsFromFile(dockerCli.In(), opts.fromFile)
+				if err != nil {
+					return err
+				}
+				opts.pairs = pairs
+			} else if opts.autoName {
+				opts.pairs = []renamePair{{oldName: args[0]}}
+			} else {
+				opts.pairs = parseRenamePairs(args)
+			}
 			return runRename(cmd.Context(), dockerCli, &opts)
 		},
 		Annotations: map[string]string{
 			"aliases": "docker container rename, docker rename",
 		},
-		ValidArgsFunction: completion.ContainerNames(dockerCli, true),
+		ValidArgsFunction: completeRenameArgs(dockerCli),
 	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.fromFile, "from-file", "", `Read "CONTAINER NEW_NAME" pairs from a file, one per line ("-" to read from stdin)`)
+	flags.BoolVar(&opts.dryRun, "dry-run", false, "Validate the renames without renaming any containers")
+	flags.BoolVar(&opts.autoName, "auto", false, "Assign a generated name to the container, and print the name")
+	flags.BoolVar(&opts.strictExitCodes, "strict-exit-codes", false, strictExitCodesHelp)
 	return cmd
 }
 
+// renameCompletionTimeout is the maximum time to wait for the daemon when
+// completing container names, so that completion doesn't hang if the daemon
+// is unreachable.
+const renameCompletionTimeout = 2 * time.Second
+
+// completeRenameArgs offers completion for the CONTAINER NEW_NAME pairs of
+// `docker rename`. Containers are completed for the CONTAINER positions,
+// excluding containers that were already passed. For the NEW_NAME positions,
+// the container's name with a "-new" suffix is suggested. No completion is
+// offered when the daemon cannot be reached.
+func completeRenameArgs(dockerCli command.Cli) completion.ValidArgsFn {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if f := cmd.Flags().Lookup("from-file"); f != nil && f.Changed {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		if autoName, _ := cmd.Flags().GetBool("auto"); autoName && len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		if len(args)%2 == 1 {
+			return []string{args[len(args)-1] + "-new"}, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		ctx, cancel := context.WithTimeout(cmd.Context(), renameCompletionTimeout)
+		defer cancel()
+		list, err := dockerCli.Client().ContainerList(ctx, container.ListOptions{All: true})
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		seen := make(map[string]bool, len(args)/2)
+		for i := 0; i < len(args); i += 2 {
+			seen[strings.TrimPrefix(args[i], "/")] = true
+		}
+		showContainerIDs := os.Getenv("DOCKER_COMPLETION_SHOW_CONTAINER_IDS") == "yes"
+
+		var names []string
+		for _, ctr := range list {
+			ctrNames := formatter.StripNamePrefix(ctr.Names)
+			if seen[ctr.ID] || containsAny(ctrNames, seen) {
+				continue
+			}
+			if showContainerIDs {
+				names = append(names, ctr.ID)
+			}
+			names = append(names, ctrNames...)
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func containsAny(values []string, set map[string]bool) bool {
+	for _, v := range values {
+		if set[v] {
+			return true
+		}
+	}
+	return false
+}
+
+// requiresRenamePairs validates that args is a non-empty list of
+// CONTAINER NEW_NAME pairs, that no arguments are passed if the pairs
+// are read from a file, or that a single CONTAINER is passed if a name
+// is generated.
+func requiresRenamePairs(cmd *cobra.Command, args []string) error {
+	if autoName, _ := cmd.Flags().GetBool("auto"); autoName {
+		switch {
+		case cmd.Flags().Changed("from-file"):
+			return errors.New("conflicting options: cannot specify both --auto and --from-file")
+		case cmd.Flags().Changed("dry-run"):
+			return errors.New("conflicting options: cannot specify both --auto and --dry-run")
+		case len(args) == 2:
+			return errors.New("conflicting options: either specify --auto or provide NEW_NAME, not both")
+		}
+		return cli.ExactArgs(1)(cmd, args)
+	}
+	if f := cmd.Flags().Lookup("from-file"); f != nil && f.Changed {
+		if len(args) > 0 {
+			return errors.Errorf(
+				"%q accepts no arguments when --from-file is set.\nSee '%s --help'.\n\nUsage:  %s\n\n%s",
+				cmd.CommandPath(),
+				cmd.CommandPath(),
+				cmd.UseLine(),
+				cmd.Short,
+			)
+		}
+		return nil
+	}
+	if len(args) == 2 {
+		return nil
+	}
+	if len(args) < 2 {
+		return cli.ExactArgs(2)(cmd, args)
+	}
+	if len(args)%2 != 0 {
+		return errors.Errorf(
+			"%q requires an even number of arguments (CONTAINER NEW_NAME pairs), got %d.\nSee '%s --help'.\n\nUsage:  %s\n\n%s",
+			cmd.CommandPath(),
+			len(args),
+			cmd.CommandPath(),
+			cmd.UseLine(),
+			cmd.Short,
+		)
+	}
+	return nil
+}
+
+func parseRenamePairs(args []string) []renamePair {
+	pairs := make([]renamePair, 0, len(args)/2)
+	for i := 0; i+1 < len(args); i += 2 {
+		pairs = append(pairs, renamePair{oldName: args[i], newName: args[i+1]})
+	}
+	return pairs
+}
+
+// readRenamePairsFromFile reads rename pairs from the given file, or from
+// stdin if filename is "-".
+func readRenamePairsFromFile(stdin io.Reader, filename string) ([]renamePair, error) {
+	if filename == "-" {
+		return readRenamePairs(stdin, "stdin")
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readRenamePairs(f, filename)
+}
+
+// readRenamePairs parses a list of rename pairs. Each line must contain
+// exactly two whitespace-separated fields (the container and its new name).
+// Empty lines are ignored, and everything after a "#" is treated as a comment.
+func readRenamePairs(r io.Reader, source string) ([]renamePair, error) {
+	var pairs []renamePair
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+		switch len(fields) {
+		case 0:
+			continue
+		case 2:
+			pairs = append(pairs, renamePair{oldName: fields[0], newName: fields[1]})
+		default:
+			return nil, errors.Errorf("invalid rename pair in %s at line %d: expected CONTAINER NEW_NAME, got %q", source, lineNum, strings.TrimSpace(line))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read rename pairs from %s", source)
+	}
+	if len(pairs) == 0 {
+		return nil, errors.Errorf("no rename pairs found in %s", source)
+	}
+	return pairs, nil
+}
+
 func runRename(ctx context.Context, dockerCli command.Cli, opts *renameOptions) error {
-	oldName := strings.TrimSpace(opts.oldName)
-	newName := strings.TrimSpace(opts.newName)
+	if opts.dryRun {
+		return runRenameDryRun(ctx, dockerCli, opts)
+	}
+	if opts.autoName {
+		return runRenameAuto(ctx, dockerCli, opts)
+	}
+	if len(opts.pairs) == 1 && opts.fromFile == "" && !opts.strictExitCodes {
+		return renameContainer(ctx, dockerCli, opts.pairs[0])
+	}
+
+	var errs []error
+	for _, pair := range opts.pairs {
+		if err := renameContainer(ctx, dockerCli, pair); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if opts.fromFile != "" {
+		printRenameSummary(dockerCli.Out(), "Renamed", len(opts.pairs), len(errs))
+	}
+	return joinErrors(errs, opts.strictExitCodes)
+}
+
+// renameError is returned when the daemon failed to rename a container. The
+// error returned by the daemon is printed separately, but preserved so that
+// its class can be used to determine the exit code.
+type renameError struct {
+	oldName string
+	cause   error
+}
+
+func (e renameError) Error() string {
+	return "Error: failed to rename container named " + e.oldName
+}
+
+func (e renameError) Unwrap() error {
+	return e.cause
+}
+
+func renameContainer(ctx context.Context, dockerCli command.Cli, pair renamePair) error {
+	oldName := strings.TrimSpace(pair.oldName)
+	newName := strings.TrimSpace(pair.newName)
 
 	if oldName == "" || newName == "" {
 		return errors.New("Error: Neither old nor new names may be empty")
@@ -48,7 +284,125 @@ func runRename(ctx context.Context, dockerCli command.Cli, opts *renameOptions)
 
 	if err := dockerCli.Client().ContainerRename(ctx, oldName, newName); err != nil {
 		fmt.Fprintln(dockerCli.Err(), err)
-		return errors.Errorf("Error: failed to rename container named %s", oldName)
+		return renameError{oldName: oldName, cause: err}
+	}
+	return nil
+}
+
+// maxAutoRenameAttempts is the number of generated names to try when
+// renaming a container with --auto, before giving up because the generated
+// names are already in use.
+const maxAutoRenameAttempts = 5
+
+// runRenameAuto renames a container to a generated name, and prints the new
+// name. A new name is generated if the generated name is already in use.
+func runRenameAuto(ctx context.Context, dockerCli command.Cli, opts *renameOptions) error {
+	oldName := strings.TrimSpace(opts.pairs[0].oldName)
+	if oldName == "" {
+		return errors.New("Error: Neither old nor new names may be empty")
+	}
+
+	c, err := dockerCli.Client().ContainerInspect(ctx, oldName)
+	if err != nil {
+		return joinErrors([]error{err}, opts.strictExitCodes)
+	}
+	var imageRef string
+	if c.Config != nil {
+		imageRef = c.Config.Image
+	}
+
+	for i := 0; i < maxAutoRenameAttempts; i++ {
+		newName := generateContainerName(imageRef)
+		err = dockerCli.Client().ContainerRename(ctx, oldName, newName)
+		if err == nil {
+			fmt.Fprintln(dockerCli.Out(), newName)
+			return nil
+		}
+		if !errdefs.IsConflict(err) {
+			break
+		}
+	}
+	fmt.Fprintln(dockerCli.Err(), err)
+	return joinErrors([]error{renameError{oldName: oldName, cause: err}}, opts.strictExitCodes)
+}
+
+// generateContainerName generates a container name in "<image>-<suffix>"
+// form, where "<image>" is the last path-component of the given image
+// reference (or "container" if the reference is not a name), and "<suffix>"
+// is a random suffix.
+func generateContainerName(imageRef string) string {
+	base := "container"
+	if ref, err := reference.ParseNormalizedNamed(imageRef); err == nil && !strings.HasPrefix(imageRef, "sha256:") {
+		p := reference.Path(ref)
+		base = p[strings.LastIndex(p, "/")+1:]
+	}
+	return base + "-" + stringid.GenerateRandomID()[:6]
+}
+
+// runRenameDryRun validates the rename pairs without renaming any containers.
+// New names are validated on the client side, and the existence of the
+// containers to rename (and of any container already using a new name) is
+// checked using ContainerInspect. Renames earlier in the list are taken into
+// account when checking later pairs.
+func runRenameDryRun(ctx context.Context, dockerCli command.Cli, opts *renameOptions) error {
+	var (
+		pairs   = opts.pairs
+		errs    []error
+		claimed = map[string]bool{}
+		freed   = map[string]bool{}
+	)
+	for _, pair := range pairs {
+		oldName := strings.TrimPrefix(strings.TrimSpace(pair.oldName), "/")
+		newName := strings.TrimPrefix(strings.TrimSpace(pair.newName), "/")
+		if err := validateRename(ctx, dockerCli, oldName, newName, claimed, freed); err != nil {
+			errs = append(errs, errors.WithMessagef(err, "Error: cannot rename container named %s", oldName))
+			continue
+		}
+		fmt.Fprintf(dockerCli.Out(), "Would rename %s to %s\n", oldName, newName)
+		delete(claimed, oldName)
+		freed[oldName] = true
+		delete(freed, newName)
+		claimed[newName] = true
+	}
+	printRenameSummary(dockerCli.Out(), "Would rename", len(pairs), len(errs))
+	return joinErrors(errs, opts.strictExitCodes)
+}
+
+func validateRename(ctx context.Context, dockerCli command.Cli, oldName, newName string, claimed, freed map[string]bool) error {
+	if oldName == "" || newName == "" {
+		return errors.New("neither old nor new names may be empty")
+	}
+	if !validContainerName.MatchString(newName) {
+		return errors.Errorf("invalid container name (%s), only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed", newName)
+	}
+	if oldName == newName {
+		return errors.New("renaming a container with the same name as its current name")
+	}
+	if !claimed[oldName] {
+		if freed[oldName] {
+			return errdefs.NotFound(errors.Errorf("no such container: %s", oldName))
+		}
+		if _, err := dockerCli.Client().ContainerInspect(ctx, oldName); err != nil {
+			return err
+		}
+	}
+	if claimed[newName] {
+		return errdefs.Conflict(errors.Errorf("the name %s is already used by a container renamed earlier in the list", newName))
+	}
+	if !freed[newName] {
+		// ContainerInspect also resolves ID-prefixes, so only consider the
+		// name to be in use if it's the name of the container found.
+		c, err := dockerCli.Client().ContainerInspect(ctx, newName)
+		switch {
+		case err == nil && c.ContainerJSONBase != nil && c.Name == "/"+newName:
+			return errdefs.Conflict(errors.Errorf("the name %s is already in use by container %s", newName, c.ID))
+		case err != nil && !errdefs.IsNotFound(err):
+			return err
+		}
 	}
 	return nil
 }
+
+func printRenameSummary(out io.Writer, action string, total, failed int) {
+	fmt.Fprintf(out, "%s %d of %d containers (%d failed)\n", action, total-failed, total, failed)
+}
@@ -0,0 +1,501 @@
+package container
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/docker/cli/cli"
+	"github.com/docker/cli/cli/streams"
+	"github.com/docker/cli/internal/test"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/errdefs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+)
+
+func TestNewRenameCommandArgs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{
+			name:          "no arguments",
+			args:          []string{},
+			expectedError: "requires exactly 2 arguments",
+		},
+		{
+			name:          "single argument",
+			args:          []string{"container"},
+			expectedError: "requires exactly 2 arguments",
+		},
+		{
+			name:          "odd number of arguments",
+			args:          []string{"a", "a1", "b"},
+			expectedError: "requires an even number of arguments (CONTAINER NEW_NAME pairs), got 3",
+		},
+		{
+			name: "single pair",
+			args: []string{"a", "a1"},
+		},
+		{
+			name: "multiple pairs",
+			args: []string{"a", "a1", "b", "b1"},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := NewRenameCommand(test.NewFakeCli(&fakeClient{}))
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NilError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewRenameCommandArgsUsage(t *testing.T) {
+	cmd := NewRenameCommand(test.NewFakeCli(&fakeClient{}))
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{"container"})
+
+	err := cmd.Execute()
+	assert.Error(t, err, "\"rename\" requires exactly 2 arguments.\nSee 'rename --help'.\n\nUsage:  rename [OPTIONS] CONTAINER NEW_NAME [flags]\n\nRename a container")
+}
+
+func TestRunRename(t *testing.T) {
+	testCases := []struct {
+		name            string
+		args            []string
+		expectedRenames [][2]string
+		expectedError   string
+		expectedStderr  string
+	}{
+		{
+			name:            "single pair",
+			args:            []string{"a", "a1"},
+			expectedRenames: [][2]string{{"a", "a1"}},
+		},
+		{
+			name:            "single pair failure",
+			args:            []string{"missing", "x"},
+			expectedRenames: [][2]string{{"missing", "x"}},
+			expectedError:   "Error: failed to rename container named missing",
+			expectedStderr:  "No such container: missing\n",
+		},
+		{
+			name:            "empty name",
+			args:            []string{" ", "x"},
+			expectedRenames: nil,
+			expectedError:   "Error: Neither old nor new names may be empty",
+		},
+		{
+			name:            "continues after a failed pair",
+			args:            []string{"a", "a1", "missing", "x", "b", "b1"},
+			expectedRenames: [][2]string{{"a", "a1"}, {"missing", "x"}, {"b", "b1"}},
+			expectedError:   "Error: failed to rename container named missing",
+			expectedStderr:  "No such container: missing\n",
+		},
+		{
+			name:            "reports every failed pair",
+			args:            []string{"missing", "x", "a", "a1", "other", "y"},
+			expectedRenames: [][2]string{{"missing", "x"}, {"a", "a1"}, {"other", "y"}},
+			expectedError:   "Error: failed to rename container named missing\nError: failed to rename container named other",
+			expectedStderr:  "No such container: missing\nNo such container: other\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var renames [][2]string
+			cli := test.NewFakeCli(&fakeClient{
+				containerRenameFunc: func(_ context.Context, oldName, newName string) error {
+					renames = append(renames, [2]string{oldName, newName})
+					if oldName == "a" || oldName == "b" {
+						return nil
+					}
+					return errdefs.NotFound(errors.New("No such container: " + oldName))
+				},
+			})
+			cmd := NewRenameCommand(cli)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectedError != "" {
+				assert.Error(t, err, tc.expectedError)
+			} else {
+				assert.NilError(t, err)
+			}
+			assert.Check(t, is.DeepEqual(renames, tc.expectedRenames))
+			assert.Check(t, is.Equal(cli.ErrBuffer().String(), tc.expectedStderr))
+		})
+	}
+}
+
+func TestReadRenamePairs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		expected      []renamePair
+		expectedError string
+	}{
+		{
+			name:  "pairs with comments and blank lines",
+			input: "# migrate to blue\napp-1 app-blue-1\n\n  app-2\tapp-blue-2  # second\n",
+			expected: []renamePair{
+				{oldName: "app-1", newName: "app-blue-1"},
+				{oldName: "app-2", newName: "app-blue-2"},
+			},
+		},
+		{
+			name:          "missing new name",
+			input:         "app-1 app-blue-1\napp-2\n",
+			expectedError: `invalid rename pair in stdin at line 2: expected CONTAINER NEW_NAME, got "app-2"`,
+		},
+		{
+			name:          "too many fields",
+			input:         "# header\n\napp-1 app-blue-1 extra\n",
+			expectedError: `invalid rename pair in stdin at line 3: expected CONTAINER NEW_NAME, got "app-1 app-blue-1 extra"`,
+		},
+		{
+			name:          "only comments",
+			input:         "# nothing to do\n\n",
+			expectedError: "no rename pairs found in stdin",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pairs, err := readRenamePairs(strings.NewReader(tc.input), "stdin")
+			if tc.expectedError != "" {
+				assert.Error(t, err, tc.expectedError)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Check(t, is.DeepEqual(pairs, tc.expected, cmp.AllowUnexported(renamePair{})))
+		})
+	}
+}
+
+func TestRenameFromFile(t *testing.T) {
+	var renames [][2]string
+	cli := test.NewFakeCli(&fakeClient{
+		containerRenameFunc: func(_ context.Context, oldName, newName string) error {
+			renames = append(renames, [2]string{oldName, newName})
+			if oldName == "missing" {
+				return errdefs.NotFound(errors.New("No such container: missing"))
+			}
+			return nil
+		},
+	})
+	cli.SetIn(streams.NewIn(io.NopCloser(strings.NewReader("a a1\nmissing x\nb b1\n"))))
+	cmd := NewRenameCommand(cli)
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{"--from-file", "-"})
+
+	err := cmd.Execute()
+	assert.Error(t, err, "Error: failed to rename container named missing")
+	assert.Check(t, is.DeepEqual(renames, [][2]string{{"a", "a1"}, {"missing", "x"}, {"b", "b1"}}))
+	assert.Check(t, is.Equal(cli.OutBuffer().String(), "Renamed 2 of 3 containers (1 failed)\n"))
+}
+
+func TestRenameFromFileWithArgs(t *testing.T) {
+	cmd := NewRenameCommand(test.NewFakeCli(&fakeClient{}))
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{"--from-file", "-", "a", "a1"})
+	assert.ErrorContains(t, cmd.Execute(), "accepts no arguments when --from-file is set")
+}
+
+func TestRenameDryRun(t *testing.T) {
+	containers := map[string]string{
+		"aa":    "aaaaaaaaaaaa",
+		"bb":    "bbbbbbbbbbbb",
+		"taken": "cccccccccccc",
+	}
+	cli := test.NewFakeCli(&fakeClient{
+		inspectFunc: func(ref string) (types.ContainerJSON, error) {
+			id, ok := containers[ref]
+			if !ok {
+				return types.ContainerJSON{}, errdefs.NotFound(errors.New("No such container: " + ref))
+			}
+			return types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{ID: id, Name: "/" + ref},
+			}, nil
+		},
+		containerRenameFunc: func(context.Context, string, string) error {
+			t.Fatal("containers must not be renamed on dry-run")
+			return nil
+		},
+	})
+	input := `aa aa1
+missing xx
+bb taken
+bb aa      # "aa" is freed by the first rename
+aa1 aa2    # "aa1" is the result of the first rename
+bb bad/name
+`
+	cli.SetIn(streams.NewIn(io.NopCloser(strings.NewReader(input))))
+	cmd := NewRenameCommand(cli)
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{"--dry-run", "--from-file", "-"})
+
+	err := cmd.Execute()
+	assert.Error(t, err, `Error: cannot rename container named missing: No such container: missing
+Error: cannot rename container named bb: the name taken is already in use by container cccccccccccc
+Error: cannot rename container named bb: invalid container name (bad/name), only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed`)
+	assert.Check(t, is.Equal(cli.OutBuffer().String(), `Would rename aa to aa1
+Would rename bb to aa
+Would rename aa1 to aa2
+Would rename 3 of 6 containers (3 failed)
+`))
+}
+
+func TestCompleteRenameArgs(t *testing.T) {
+	containers := []types.Container{
+		{ID: "aaaaaaaaaaaa", Names: []string{"/web"}},
+		{ID: "bbbbbbbbbbbb", Names: []string{"/db", "/web/mysql"}},
+		{ID: "cccccccccccc", Names: []string{"/cache"}},
+	}
+	testCases := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "first container",
+			args:     []string{},
+			expected: []string{"web", "db", "web/mysql", "cache"},
+		},
+		{
+			name:     "new name",
+			args:     []string{"web"},
+			expected: []string{"web-new"},
+		},
+		{
+			name:     "second container excludes containers already passed",
+			args:     []string{"web", "web-new"},
+			expected: []string{"db", "web/mysql", "cache"},
+		},
+		{
+			name:     "second new name",
+			args:     []string{"web", "web-new", "db"},
+			expected: []string{"db-new"},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cli := test.NewFakeCli(&fakeClient{
+				containerListFunc: func(options container.ListOptions) ([]types.Container, error) {
+					assert.Check(t, options.All)
+					return containers, nil
+				},
+			})
+			cmd := NewRenameCommand(cli)
+			cmd.SetContext(context.Background())
+			names, directive := completeRenameArgs(cli)(cmd, tc.args, "")
+			assert.Check(t, is.DeepEqual(names, tc.expected))
+			assert.Check(t, is.Equal(directive, cobra.ShellCompDirectiveNoFileComp))
+		})
+	}
+}
+
+func TestCompleteRenameArgsDaemonUnreachable(t *testing.T) {
+	cli := test.NewFakeCli(&fakeClient{
+		containerListFunc: func(container.ListOptions) ([]types.Container, error) {
+			return nil, errors.New("Cannot connect to the Docker daemon")
+		},
+	})
+	cmd := NewRenameCommand(cli)
+	cmd.SetContext(context.Background())
+	names, directive := completeRenameArgs(cli)(cmd, nil, "")
+	assert.Check(t, is.Len(names, 0))
+	assert.Check(t, is.Equal(directive, cobra.ShellCompDirectiveNoFileComp))
+}
+
+func TestRenameStrictExitCodes(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedStatus int
+	}{
+		{name: "success", args: []string{"aa", "aa1"}},
+		{name: "not found", args: []string{"missing", "xx"}, expectedStatus: 2},
+		{name: "conflict", args: []string{"aa", "taken"}, expectedStatus: 3},
+		{name: "mixed", args: []string{"missing", "xx", "aa", "taken"}, expectedStatus: 1},
+		{name: "empty name", args: []string{" ", "xx"}, expectedStatus: 1},
+		{name: "dry-run not found", args: []string{"--dry-run", "missing", "xx"}, expectedStatus: 2},
+		{name: "dry-run conflict", args: []string{"--dry-run", "aa", "taken"}, expectedStatus: 3},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fakeCli := test.NewFakeCli(&fakeClient{
+				inspectFunc: func(ref string) (types.ContainerJSON, error) {
+					if ref == "missing" || ref == "xx" {
+						return types.ContainerJSON{}, errdefs.NotFound(errors.New("No such container: " + ref))
+					}
+					return types.ContainerJSON{
+						ContainerJSONBase: &types.ContainerJSONBase{ID: ref + "-id", Name: "/" + ref},
+					}, nil
+				},
+				containerRenameFunc: func(_ context.Context, oldName, newName string) error {
+					switch {
+					case oldName == "missing":
+						return errdefs.NotFound(errors.New("No such container: " + oldName))
+					case newName == "taken":
+						return errdefs.Conflict(errors.New("Conflict. The container name \"/taken\" is already in use"))
+					}
+					return nil
+				},
+			})
+			cmd := NewRenameCommand(fakeCli)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			cmd.SetArgs(append([]string{"--strict-exit-codes"}, tc.args...))
+
+			err := cmd.Execute()
+			if tc.expectedStatus == 0 {
+				assert.NilError(t, err)
+				return
+			}
+			var statusErr cli.StatusError
+			assert.Assert(t, errors.As(err, &statusErr))
+			assert.Check(t, is.Equal(statusErr.StatusCode, tc.expectedStatus))
+		})
+	}
+}
+
+func TestRenameAuto(t *testing.T) {
+	var candidates []string
+	fakeCli := test.NewFakeCli(&fakeClient{
+		inspectFunc: func(ref string) (types.ContainerJSON, error) {
+			return types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{ID: "aaaaaaaaaaaa", Name: "/" + ref},
+				Config:            &container.Config{Image: "docker.io/library/nginx:alpine"},
+			}, nil
+		},
+		containerRenameFunc: func(_ context.Context, oldName, newName string) error {
+			assert.Check(t, is.Equal(oldName, "aaaaaaaaaaaa"))
+			candidates = append(candidates, newName)
+			if len(candidates) <= 2 {
+				return errdefs.Conflict(errors.New("Conflict. The container name \"/" + newName + "\" is already in use"))
+			}
+			return nil
+		},
+	})
+	cmd := NewRenameCommand(fakeCli)
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{"--auto", "aaaaaaaaaaaa"})
+
+	assert.NilError(t, cmd.Execute())
+	assert.Assert(t, is.Len(candidates, 3))
+	for _, c := range candidates {
+		assert.Check(t, strings.HasPrefix(c, "nginx-"), c)
+		assert.Check(t, validContainerName.MatchString(c), c)
+	}
+	assert.Check(t, candidates[0] != candidates[1] && candidates[1] != candidates[2])
+	assert.Check(t, is.Equal(fakeCli.OutBuffer().String(), candidates[2]+"\n"))
+}
+
+func TestRenameAutoGivesUp(t *testing.T) {
+	var attempts int
+	fakeCli := test.NewFakeCli(&fakeClient{
+		containerRenameFunc: func(_ context.Context, _, newName string) error {
+			attempts++
+			return errdefs.Conflict(errors.New("Conflict. The container name \"/" + newName + "\" is already in use"))
+		},
+	})
+	cmd := NewRenameCommand(fakeCli)
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{"--auto", "--strict-exit-codes", "web"})
+
+	err := cmd.Execute()
+	var statusErr cli.StatusError
+	assert.Assert(t, errors.As(err, &statusErr))
+	assert.Check(t, is.Equal(statusErr.Status, "Error: failed to rename container named web"))
+	assert.Check(t, is.Equal(statusErr.StatusCode, 3))
+	assert.Check(t, is.Equal(attempts, maxAutoRenameAttempts))
+	assert.Check(t, is.Equal(fakeCli.OutBuffer().String(), ""))
+}
+
+func TestRenameAutoArgs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{
+			name:          "no container",
+			args:          []string{"--auto"},
+			expectedError: "requires exactly 1 argument",
+		},
+		{
+			name:          "with new name",
+			args:          []string{"--auto", "web", "web-new"},
+			expectedError: "conflicting options: either specify --auto or provide NEW_NAME, not both",
+		},
+		{
+			name:          "with from-file",
+			args:          []string{"--auto", "--from-file", "-"},
+			expectedError: "conflicting options: cannot specify both --auto and --from-file",
+		},
+		{
+			name:          "with dry-run",
+			args:          []string{"--auto", "--dry-run", "web"},
+			expectedError: "conflicting options: cannot specify both --auto and --dry-run",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := NewRenameCommand(test.NewFakeCli(&fakeClient{}))
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			cmd.SetArgs(tc.args)
+			assert.ErrorContains(t, cmd.Execute(), tc.expectedError)
+		})
+	}
+}
+
+func TestGenerateContainerName(t *testing.T) {
+	testCases := []struct {
+		image          string
+		expectedPrefix string
+	}{
+		{image: "nginx", expectedPrefix: "nginx-"},
+		{image: "registry.example.com:5000/team/my-app:1.0", expectedPrefix: "my-app-"},
+		{image: "alpine@sha256:4edbd2beb5f78b1014028f4fbb99f3237d9561100b6881aabbf5acce2c4f9454", expectedPrefix: "alpine-"},
+		{image: "sha256:4edbd2beb5f78b1014028f4fbb99f3237d9561100b6881aabbf5acce2c4f9454", expectedPrefix: "container-"},
+		{image: "", expectedPrefix: "container-"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.image, func(t *testing.T) {
+			name := generateContainerName(tc.image)
+			assert.Check(t, strings.HasPrefix(name, tc.expectedPrefix), name)
+			assert.Check(t, validContainerName.MatchString(name), name)
+		})
+	}
+}
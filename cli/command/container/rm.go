@@ -19,6 +19,9 @@ type rmOptions struct {
 	rmLink    bool
 	force     bool
 
+	strictExitCodes bool
+	yes             bool
+
 	containers []string
 }
 
@@ -45,11 +48,17 @@ func NewRmCommand(dockerCli command.Cli) *cobra.Command {
 	flags.BoolVarP(&opts.rmVolumes, "volumes", "v", false, "Remove anonymous volumes associated with the container")
 	flags.BoolVarP(&opts.rmLink, "link", "l", false, "Remove the specified link")
 	flags.BoolVarP(&opts.force, "force", "f", false, "Force the removal of a running container (uses SIGKILL)")
+	flags.BoolVar(&opts.strictExitCodes, "strict-exit-codes", false, strictExitCodesHelp)
+	flags.BoolVar(&opts.yes, "yes", false, yesHelp)
 	return cmd
 }
 
 func runRm(ctx context.Context, dockerCli command.Cli, opts *rmOptions) error {
-	var errs []string
+	if err := confirmBulkOperation(dockerCli, "remove", opts.containers, opts.yes); err != nil {
+		return err
+	}
+
+	var errs []error
 	errChan := parallelOperation(ctx, opts.containers, func(ctx context.Context, ctrID string) error {
 		ctrID = strings.Trim(ctrID, "/")
 		if ctrID == "" {
@@ -68,13 +77,10 @@ func runRm(ctx context.Context, dockerCli command.Cli, opts *rmOptions) error {
 				fmt.Fprintln(dockerCli.Err(), err)
 				continue
 			}
-			errs = append(errs, err.Error())
+			errs = append(errs, err)
 			continue
 		}
 		fmt.Fprintln(dockerCli.Out(), name)
 	}
-	if len(errs) > 0 {
-		return errors.New(strings.Join(errs, "\n"))
-	}
-	return nil
+	return joinErrors(errs, opts.strictExitCodes)
 }
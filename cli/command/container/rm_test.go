@@ -2,12 +2,16 @@ package container
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"sync"
 	"testing"
 
+	"github.com/docker/cli/cli"
+	"github.com/docker/cli/cli/streams"
 	"github.com/docker/cli/internal/test"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/errdefs"
@@ -58,3 +62,122 @@ func TestRemoveForce(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveStrictExitCodes(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		args           []string
+		expectedStatus int
+	}{
+		{name: "success", args: []string{"mycontainer"}},
+		{name: "not found", args: []string{"nosuchcontainer", "mycontainer"}, expectedStatus: 2},
+		{name: "conflict", args: []string{"runningcontainer"}, expectedStatus: 3},
+		{name: "mixed", args: []string{"nosuchcontainer", "runningcontainer"}, expectedStatus: 1},
+		{name: "generic", args: []string{"brokencontainer"}, expectedStatus: 1},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fakeCli := test.NewFakeCli(&fakeClient{
+				containerRemoveFunc: func(ctx context.Context, container string, options container.RemoveOptions) error {
+					switch container {
+					case "nosuchcontainer":
+						return errdefs.NotFound(fmt.Errorf("Error: no such container: %s", container))
+					case "runningcontainer":
+						return errdefs.Conflict(fmt.Errorf("Error: cannot remove running container: %s", container))
+					case "brokencontainer":
+						return errors.New("Error: something went wrong")
+					}
+					return nil
+				},
+				Version: "1.36",
+			})
+			cmd := NewRmCommand(fakeCli)
+			cmd.SetOut(io.Discard)
+			cmd.SetArgs(append([]string{"--strict-exit-codes"}, tc.args...))
+
+			err := cmd.Execute()
+			if tc.expectedStatus == 0 {
+				assert.NilError(t, err)
+				return
+			}
+			var statusErr cli.StatusError
+			assert.Assert(t, errors.As(err, &statusErr))
+			assert.Equal(t, statusErr.StatusCode, tc.expectedStatus)
+		})
+	}
+}
+
+func TestRemoveWithoutStrictExitCodes(t *testing.T) {
+	fakeCli := test.NewFakeCli(&fakeClient{
+		containerRemoveFunc: func(ctx context.Context, container string, options container.RemoveOptions) error {
+			return errdefs.NotFound(fmt.Errorf("Error: no such container: %s", container))
+		},
+		Version: "1.36",
+	})
+	cmd := NewRmCommand(fakeCli)
+	cmd.SetOut(io.Discard)
+	cmd.SetArgs([]string{"nosuchcontainer"})
+
+	err := cmd.Execute()
+	assert.Error(t, err, "Error: no such container: nosuchcontainer")
+	var statusErr cli.StatusError
+	assert.Check(t, !errors.As(err, &statusErr))
+}
+
+func TestRemoveProtectedContext(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		args            []string
+		input           string
+		expectedRemoved []string
+		expectedErr     string
+	}{
+		{
+			name:            "confirmed",
+			args:            []string{"a", "b", "c"},
+			input:           "y\n",
+			expectedRemoved: []string{"a", "b", "c"},
+		},
+		{
+			name:        "declined",
+			args:        []string{"a", "b", "c"},
+			input:       "n\n",
+			expectedErr: "canceling remove request",
+		},
+		{
+			name:            "with --yes",
+			args:            []string{"--yes", "a", "b", "c"},
+			expectedRemoved: []string{"a", "b", "c"},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var removed []string
+			mutex := new(sync.Mutex)
+
+			fakeCli := test.NewFakeCli(&fakeClient{
+				containerRemoveFunc: func(ctx context.Context, container string, options container.RemoveOptions) error {
+					mutex.Lock()
+					removed = append(removed, container)
+					mutex.Unlock()
+					return nil
+				},
+				Version: "1.36",
+			})
+			setContext(t, fakeCli, true)
+			fakeCli.SetIn(streams.NewIn(io.NopCloser(strings.NewReader(tc.input))))
+			cmd := NewRmCommand(fakeCli)
+			cmd.SetOut(io.Discard)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectedErr != "" {
+				assert.Error(t, err, tc.expectedErr)
+			} else {
+				assert.NilError(t, err)
+			}
+			sort.Strings(removed)
+			assert.DeepEqual(t, removed, tc.expectedRemoved)
+		})
+	}
+}
@@ -17,6 +17,7 @@ type stopOptions struct {
 	signal         string
 	timeout        int
 	timeoutChanged bool
+	yes            bool
 
 	containers []string
 }
@@ -43,10 +44,15 @@ func NewStopCommand(dockerCli command.Cli) *cobra.Command {
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.signal, "signal", "s", "", "Signal to send to the container")
 	flags.IntVarP(&opts.timeout, "time", "t", 0, "Seconds to wait before killing the container")
+	flags.BoolVar(&opts.yes, "yes", false, yesHelp)
 	return cmd
 }
 
 func runStop(ctx context.Context, dockerCli command.Cli, opts *stopOptions) error {
+	if err := confirmBulkOperation(dockerCli, "stop", opts.containers, opts.yes); err != nil {
+		return err
+	}
+
 	var timeout *int
 	if opts.timeoutChanged {
 		timeout = &opts.timeout
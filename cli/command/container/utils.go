@@ -2,14 +2,20 @@ package container
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/docker/cli/cli"
+	"github.com/docker/cli/cli/command"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/versions"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -158,3 +164,71 @@ func parallelOperation(ctx context.Context, containers []string, op func(ctx con
 	}()
 	return errChan
 }
+
+// Exit codes used by commands that support the --strict-exit-codes option.
+const (
+	exitCodeNotFound = 2
+	exitCodeConflict = 3
+)
+
+const strictExitCodesHelp = "Exit with status 2 if a container was not found, or 3 on a conflict"
+
+// joinErrors returns an error that combines the messages of the given errors.
+// If strictExitCodes is set, a cli.StatusError is returned, using exit code
+// exitCodeNotFound or exitCodeConflict if all the errors are of that class,
+// and 1 otherwise.
+func joinErrors(errs []error, strictExitCodes bool) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+	msg := strings.Join(msgs, "\n")
+	if !strictExitCodes {
+		return errors.New(msg)
+	}
+	return cli.StatusError{Status: msg, StatusCode: exitCodeFor(errs)}
+}
+
+func exitCodeFor(errs []error) int {
+	var notFound, conflict int
+	for _, err := range errs {
+		switch {
+		case errdefs.IsNotFound(err):
+			notFound++
+		case errdefs.IsConflict(err):
+			conflict++
+		}
+	}
+	switch {
+	case notFound == len(errs):
+		return exitCodeNotFound
+	case conflict == len(errs):
+		return exitCodeConflict
+	default:
+		return 1
+	}
+}
+
+// protectedContextMinTargets is the minimum number of containers for which
+// commands such as "docker rm" and "docker stop" prompt for confirmation when
+// using a protected context.
+const protectedContextMinTargets = 3
+
+const yesHelp = "Do not prompt for confirmation when using a protected context"
+
+// confirmBulkOperation prompts for confirmation before performing action on
+// the given containers when using a protected context, and returns an error
+// if the operation was declined. No confirmation is needed if skipPrompt is
+// set or if fewer than protectedContextMinTargets containers are given.
+func confirmBulkOperation(dockerCli command.Cli, action string, containers []string, skipPrompt bool) error {
+	if skipPrompt || len(containers) < protectedContextMinTargets {
+		return nil
+	}
+	if !command.ConfirmProtectedContext(dockerCli, fmt.Sprintf("This will %s %d containers.\nAre you sure you want to continue?", action, len(containers))) {
+		return errors.Errorf("canceling %s request", action)
+	}
+	return nil
+}
@@ -3,9 +3,15 @@ package container
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"testing"
 
+	"github.com/docker/cli/cli/command"
+	"github.com/docker/cli/cli/context/docker"
+	"github.com/docker/cli/cli/context/store"
+	"github.com/docker/cli/cli/streams"
+	"github.com/docker/cli/internal/test"
 	"github.com/docker/docker/api"
 	"github.com/docker/docker/api/types/container"
 	"gotest.tools/v3/assert"
@@ -67,3 +73,88 @@ func TestWaitExitOrRemoved(t *testing.T) {
 		assert.Check(t, is.Equal(testcase.exitCode, exitCode))
 	}
 }
+
+// setContext configures fakeCli to use a context named "production", which is
+// marked as protected if protected is set.
+func setContext(t *testing.T, fakeCli *test.FakeCli, protected bool) {
+	t.Helper()
+	dockerContext := command.DockerContext{}
+	dockerContext.SetProtected(protected)
+	contextStore := store.New(t.TempDir(), store.NewConfig(
+		func() any { return &command.DockerContext{} },
+		store.EndpointTypeGetter(docker.DockerEndpoint, func() any { return &docker.EndpointMeta{} }),
+	))
+	assert.NilError(t, contextStore.CreateOrUpdate(store.Metadata{
+		Name:     "production",
+		Metadata: dockerContext,
+		Endpoints: map[string]any{
+			docker.DockerEndpoint: docker.EndpointMeta{Host: "unix:///var/run/docker.sock"},
+		},
+	}))
+	fakeCli.SetContextStore(contextStore)
+	fakeCli.SetCurrentContext("production")
+}
+
+func TestConfirmBulkOperation(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		protected      bool
+		containers     []string
+		skipPrompt     bool
+		input          string
+		expectedPrompt bool
+		expectedErr    string
+	}{
+		{
+			name:       "unprotected context",
+			containers: []string{"a", "b", "c"},
+		},
+		{
+			name:       "protected context with few containers",
+			protected:  true,
+			containers: []string{"a", "b"},
+		},
+		{
+			name:       "protected context with --yes",
+			protected:  true,
+			containers: []string{"a", "b", "c"},
+			skipPrompt: true,
+		},
+		{
+			name:           "protected context confirmed",
+			protected:      true,
+			containers:     []string{"a", "b", "c"},
+			input:          "y\n",
+			expectedPrompt: true,
+		},
+		{
+			name:           "protected context declined",
+			protected:      true,
+			containers:     []string{"a", "b", "c"},
+			input:          "n\n",
+			expectedPrompt: true,
+			expectedErr:    "canceling remove request",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fakeCli := test.NewFakeCli(&fakeClient{})
+			setContext(t, fakeCli, tc.protected)
+			fakeCli.SetIn(streams.NewIn(io.NopCloser(strings.NewReader(tc.input))))
+
+			err := confirmBulkOperation(fakeCli, "remove", tc.containers, tc.skipPrompt)
+			if tc.expectedErr != "" {
+				assert.Error(t, err, tc.expectedErr)
+			} else {
+				assert.NilError(t, err)
+			}
+			if tc.expectedPrompt {
+				assert.Check(t, is.Equal(fakeCli.OutBuffer().String(), `WARNING! Context "production" is protected.
+This will remove 3 containers.
+Are you sure you want to continue? [y/N] `))
+			} else {
+				assert.Check(t, is.Equal(fakeCli.OutBuffer().String(), ""))
+			}
+		})
+	}
+}
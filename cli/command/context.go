@@ -6,6 +6,8 @@ package command
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/docker/cli/cli/context/store"
 )
@@ -66,3 +68,54 @@ func GetDockerContext(storeMetadata store.Metadata) (DockerContext, error) {
 	}
 	return res, nil
 }
+
+// protectedField is the metadata field used to mark a context as protected.
+const protectedField = "Protected"
+
+// Protected returns whether the context is marked as protected. Commands
+// that remove or stop multiple objects prompt for confirmation when using
+// a protected context.
+func (dc DockerContext) Protected() bool {
+	protected, _ := dc.AdditionalFields[protectedField].(bool)
+	return protected
+}
+
+// SetProtected marks the context as protected, or removes the mark.
+func (dc *DockerContext) SetProtected(protected bool) {
+	if !protected {
+		delete(dc.AdditionalFields, protectedField)
+		return
+	}
+	if dc.AdditionalFields == nil {
+		dc.AdditionalFields = make(map[string]any)
+	}
+	dc.AdditionalFields[protectedField] = true
+}
+
+// IsProtectedContext returns whether the current context is marked as
+// protected. It returns false if the context's metadata cannot be loaded.
+func IsProtectedContext(dockerCli Cli) bool {
+	if dockerCli.ContextStore() == nil {
+		return false
+	}
+	meta, err := dockerCli.ContextStore().GetMetadata(dockerCli.CurrentContext())
+	if err != nil {
+		return false
+	}
+	dc, err := GetDockerContext(meta)
+	if err != nil {
+		return false
+	}
+	return dc.Protected()
+}
+
+// ConfirmProtectedContext prompts for confirmation with the given message if
+// the current context is protected, and returns true without prompting if it
+// is not. The message is printed after a warning naming the context.
+func ConfirmProtectedContext(dockerCli Cli, message string) bool {
+	if !IsProtectedContext(dockerCli) {
+		return true
+	}
+	message = fmt.Sprintf("WARNING! Context %q is protected.\n%s", dockerCli.CurrentContext(), strings.TrimPrefix(message, "WARNING! "))
+	return PromptForConfirmation(dockerCli.In(), dockerCli.Out(), message)
+}
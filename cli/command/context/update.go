@@ -3,6 +3,7 @@ package context
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 
 	"github.com/docker/cli/cli"
 	"github.com/docker/cli/cli/command"
@@ -18,6 +19,7 @@ type UpdateOptions struct {
 	Name        string
 	Description string
 	Docker      map[string]string
+	Set         map[string]string
 }
 
 func longUpdateDescription() string {
@@ -29,6 +31,11 @@ func longUpdateDescription() string {
 		fmt.Fprintf(tw, "%s\t%s\n", d.name, d.description)
 	}
 	tw.Flush()
+	buf.WriteString("\nContext settings (--set):\n\n")
+	tw = tabwriter.NewWriter(buf, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tDESCRIPTION")
+	fmt.Fprintf(tw, "%s\t%s\n", keyProtected, "Prompt for confirmation before removing or stopping multiple containers, or pruning (true or false)")
+	tw.Flush()
 	buf.WriteString("\nExample:\n\n$ docker context update my-context --description \"some description\" --docker \"host=tcp://myserver:2376,ca=~/ca-file,cert=~/cert-file,key=~/key-file\"\n")
 	return buf.String()
 }
@@ -48,6 +55,7 @@ func newUpdateCommand(dockerCli command.Cli) *cobra.Command {
 	flags := cmd.Flags()
 	flags.StringVar(&opts.Description, "description", "", "Description of the context")
 	flags.StringToStringVar(&opts.Docker, "docker", nil, "set the docker endpoint")
+	flags.StringToStringVar(&opts.Set, "set", nil, "set context settings")
 	return cmd
 }
 
@@ -68,6 +76,9 @@ func RunUpdate(dockerCLI command.Cli, o *UpdateOptions) error {
 	if o.Description != "" {
 		dockerContext.Description = o.Description
 	}
+	if err := applyContextSettings(&dockerContext, o.Set); err != nil {
+		return err
+	}
 
 	c.Metadata = dockerContext
 
@@ -98,6 +109,26 @@ func RunUpdate(dockerCLI command.Cli, o *UpdateOptions) error {
 	return nil
 }
 
+// keyProtected is the name of the context setting to mark a context as
+// protected.
+const keyProtected = "protected"
+
+func applyContextSettings(dockerContext *command.DockerContext, settings map[string]string) error {
+	for k, v := range settings {
+		switch k {
+		case keyProtected:
+			protected, err := strconv.ParseBool(v)
+			if err != nil {
+				return errors.Errorf("invalid value for %s: %q: value must be true or false", k, v)
+			}
+			dockerContext.SetProtected(protected)
+		default:
+			return errors.Errorf("unrecognized context setting: %s", k)
+		}
+	}
+	return nil
+}
+
 func validateEndpoints(c store.Metadata) error {
 	_, err := command.GetDockerContext(c)
 	return err
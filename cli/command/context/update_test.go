@@ -65,3 +65,59 @@ func TestUpdateInvalidDockerHost(t *testing.T) {
 	})
 	assert.ErrorContains(t, err, "unable to parse docker host")
 }
+
+func TestUpdateProtected(t *testing.T) {
+	cli := makeFakeCli(t)
+	err := RunCreate(cli, &CreateOptions{
+		Name:        "test",
+		Description: "description",
+		Docker:      map[string]string{},
+	})
+	assert.NilError(t, err)
+
+	assert.NilError(t, RunUpdate(cli, &UpdateOptions{
+		Name: "test",
+		Set:  map[string]string{"protected": "true"},
+	}))
+	c, err := cli.ContextStore().GetMetadata("test")
+	assert.NilError(t, err)
+	dc, err := command.GetDockerContext(c)
+	assert.NilError(t, err)
+	assert.Check(t, dc.Protected())
+	assert.Equal(t, dc.Description, "description")
+
+	cli.SetCurrentContext("test")
+	assert.Check(t, command.IsProtectedContext(cli))
+
+	assert.NilError(t, RunUpdate(cli, &UpdateOptions{
+		Name: "test",
+		Set:  map[string]string{"protected": "false"},
+	}))
+	c, err = cli.ContextStore().GetMetadata("test")
+	assert.NilError(t, err)
+	dc, err = command.GetDockerContext(c)
+	assert.NilError(t, err)
+	assert.Check(t, !dc.Protected())
+	assert.Check(t, !command.IsProtectedContext(cli))
+}
+
+func TestUpdateInvalidSettings(t *testing.T) {
+	cli := makeFakeCli(t)
+	err := RunCreate(cli, &CreateOptions{
+		Name:   "test",
+		Docker: map[string]string{},
+	})
+	assert.NilError(t, err)
+
+	err = RunUpdate(cli, &UpdateOptions{
+		Name: "test",
+		Set:  map[string]string{"protected": "maybe"},
+	})
+	assert.ErrorContains(t, err, `invalid value for protected: "maybe"`)
+
+	err = RunUpdate(cli, &UpdateOptions{
+		Name: "test",
+		Set:  map[string]string{"unknown": "true"},
+	})
+	assert.ErrorContains(t, err, "unrecognized context setting: unknown")
+}
@@ -25,6 +25,8 @@ const (
 	mountsHeader     = "MOUNTS"
 	localVolumes     = "LOCAL VOLUMES"
 	networksHeader   = "NETWORKS"
+	healthHeader     = "HEALTH"
+	restartsHeader   = "RESTARTS"
 )
 
 // NewContainerFormat returns a Format for rendering using a Context
@@ -67,9 +69,21 @@ ports: {{- pad .Ports 1 0}}
 
 // ContainerWrite renders the context for a list of containers
 func ContainerWrite(ctx Context, containers []types.Container) error {
+	return ContainerWriteWithRestartCounts(ctx, containers, nil)
+}
+
+// ContainerWriteWithRestartCounts renders the context for a list of containers,
+// using restartCounts (indexed by container ID) for the .RestartCount field,
+// which is not included in the container list returned by the API.
+func ContainerWriteWithRestartCounts(ctx Context, containers []types.Container, restartCounts map[string]int) error {
 	render := func(format func(subContext SubContext) error) error {
 		for _, container := range containers {
-			err := format(&ContainerContext{trunc: ctx.Trunc, c: container})
+			restartCount, ok := restartCounts[container.ID]
+			var rc *int
+			if ok {
+				rc = &restartCount
+			}
+			err := format(&ContainerContext{trunc: ctx.Trunc, c: container, restartCount: rc})
 			if err != nil {
 				return err
 			}
@@ -82,13 +96,15 @@ func ContainerWrite(ctx Context, containers []types.Container) error {
 // ContainerContext is a struct used for rendering a list of containers in a Go template.
 type ContainerContext struct {
 	HeaderContext
-	trunc bool
-	c     types.Container
+	trunc        bool
+	c            types.Container
+	restartCount *int
 
 	// FieldsUsed is used in the pre-processing step to detect which fields are
-	// used in the template. It's currently only used to detect use of the .Size
+	// used in the template. It's currently used to detect use of the .Size
 	// field which (if used) automatically sets the '--size' option when making
-	// the API call.
+	// the API call, and the .RestartCount field, which requires the container
+	// to be inspected.
 	FieldsUsed map[string]any
 }
 
@@ -105,11 +121,14 @@ func NewContainerContext() *ContainerContext {
 		"Ports":        PortsHeader,
 		"State":        StateHeader,
 		"Status":       StatusHeader,
+		"Health":       healthHeader,
+		"RestartCount": restartsHeader,
 		"Size":         SizeHeader,
 		"Labels":       LabelsHeader,
 		"Mounts":       mountsHeader,
 		"LocalVolumes": localVolumes,
 		"Networks":     networksHeader,
+		"Links":        linksHeader,
 	}
 	return &containerCtx
 }
@@ -144,6 +163,19 @@ func (c *ContainerContext) Names() string {
 	return strings.Join(names, ",")
 }
 
+// Links returns a comma-separated string of the legacy links (`--link`) to
+// the container, in "<container>/<alias>" form, with their slash (/) prefix
+// stripped.
+func (c *ContainerContext) Links() string {
+	var links []string
+	for _, name := range StripNamePrefix(c.c.Names) {
+		if strings.Contains(name, "/") {
+			links = append(links, name)
+		}
+	}
+	return strings.Join(links, ",")
+}
+
 // StripNamePrefix removes prefix from string, typically container names as returned by `ContainersList` API
 func StripNamePrefix(ss []string) []string {
 	sss := make([]string, len(ss))
@@ -226,6 +258,35 @@ func (c *ContainerContext) Status() string {
 	return c.c.Status
 }
 
+// Health returns the container's health status ("starting", "healthy", or
+// "unhealthy") as included in its status, or an empty string if the container
+// has no healthcheck, or is not running.
+func (c *ContainerContext) Health() string {
+	switch {
+	case strings.HasSuffix(c.c.Status, "(health: starting)"):
+		return "starting"
+	case strings.HasSuffix(c.c.Status, "(healthy)"):
+		return "healthy"
+	case strings.HasSuffix(c.c.Status, "(unhealthy)"):
+		return "unhealthy"
+	default:
+		return ""
+	}
+}
+
+// RestartCount returns the number of times the container was restarted by
+// its restart policy, or an empty string if the count is not known.
+func (c *ContainerContext) RestartCount() string {
+	if c.FieldsUsed == nil {
+		c.FieldsUsed = map[string]any{}
+	}
+	c.FieldsUsed["RestartCount"] = struct{}{}
+	if c.restartCount == nil {
+		return ""
+	}
+	return strconv.Itoa(*c.restartCount)
+}
+
 // Size returns the container's size and virtual size (e.g. "2B (virtual 21.5MB)")
 func (c *ContainerContext) Size() string {
 	if c.FieldsUsed == nil {
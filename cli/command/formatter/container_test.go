@@ -33,6 +33,9 @@ func TestContainerPsContext(t *testing.T) {
 		{types.Container{ID: containerID}, true, stringid.TruncateID(containerID), ctx.ID},
 		{types.Container{ID: containerID}, false, containerID, ctx.ID},
 		{types.Container{Names: []string{"/foobar_baz"}}, true, "foobar_baz", ctx.Names},
+		{types.Container{Names: []string{"/foobar_baz"}}, true, "", ctx.Links},
+		{types.Container{Names: []string{"/db1", "/app1/mysql"}}, true, "app1/mysql", ctx.Links},
+		{types.Container{Names: []string{"/db1", "/app1/mysql", "/app2/db"}}, false, "app1/mysql,app2/db", ctx.Links},
 		{types.Container{Image: "ubuntu"}, true, "ubuntu", ctx.Image},
 		{types.Container{Image: "verylongimagename"}, true, "verylongimagename", ctx.Image},
 		{types.Container{Image: "verylongimagename"}, false, "verylongimagename", ctx.Image},
@@ -59,6 +62,11 @@ func TestContainerPsContext(t *testing.T) {
 		{types.Container{Created: unix}, true, time.Unix(unix, 0).String(), ctx.CreatedAt},
 		{types.Container{Ports: []types.Port{{PrivatePort: 8080, PublicPort: 8080, Type: "tcp"}}}, true, "8080/tcp", ctx.Ports},
 		{types.Container{Status: "RUNNING"}, true, "RUNNING", ctx.Status},
+		{types.Container{Status: "Up 5 seconds"}, true, "", ctx.Health},
+		{types.Container{Status: "Up 5 seconds (health: starting)"}, true, "starting", ctx.Health},
+		{types.Container{Status: "Up 2 minutes (healthy)"}, true, "healthy", ctx.Health},
+		{types.Container{Status: "Up 2 minutes (unhealthy)"}, true, "unhealthy", ctx.Health},
+		{types.Container{Status: "Exited (0) 8 days ago"}, true, "", ctx.Health},
 		{types.Container{SizeRw: 10}, true, "10B", ctx.Size},
 		{types.Container{SizeRw: 10, SizeRootFs: 20}, true, "10B (virtual 20B)", ctx.Size},
 		{types.Container{}, true, "", ctx.Labels},
@@ -335,6 +343,39 @@ func TestContainerContextWriteWithNoContainers(t *testing.T) {
 	}
 }
 
+func TestContainerContextWriteLinks(t *testing.T) {
+	containers := []types.Container{
+		{ID: "containerID1", Names: []string{"/db1", "/app1/mysql", "/app2/mysql"}},
+		{ID: "containerID2", Names: []string{"/app1"}},
+	}
+	out := bytes.NewBufferString("")
+	err := ContainerWrite(Context{Format: NewContainerFormat("table {{.Names}}\t{{.Links}}", false, false), Trunc: true, Output: out}, containers)
+	assert.NilError(t, err)
+	expected := `NAMES     LINKS
+db1       app1/mysql,app2/mysql
+app1      
+`
+	assert.Equal(t, out.String(), expected)
+}
+
+func TestContainerContextWriteRestartCount(t *testing.T) {
+	containers := []types.Container{
+		{ID: "containerID1", Names: []string{"/c1"}},
+		{ID: "containerID2", Names: []string{"/c2"}},
+		{ID: "containerID3", Names: []string{"/c3"}},
+	}
+	restartCounts := map[string]int{"containerID1": 0, "containerID2": 5}
+	out := bytes.NewBufferString("")
+	err := ContainerWriteWithRestartCounts(Context{Format: NewContainerFormat("table {{.Names}}\t{{.RestartCount}}", false, false), Trunc: true, Output: out}, containers, restartCounts)
+	assert.NilError(t, err)
+	expected := `NAMES     RESTARTS
+c1        0
+c2        5
+c3        
+`
+	assert.Equal(t, out.String(), expected)
+}
+
 func TestContainerContextWriteJSON(t *testing.T) {
 	unix := time.Now().Add(-65 * time.Second).Unix()
 	containers := []types.Container{
@@ -346,14 +387,17 @@ func TestContainerContextWriteJSON(t *testing.T) {
 		{
 			"Command":      "\"\"",
 			"CreatedAt":    expectedCreated,
+			"Health":       "",
 			"ID":           "containerID1",
 			"Image":        "ubuntu",
 			"Labels":       "",
+			"Links":        "",
 			"LocalVolumes": "0",
 			"Mounts":       "",
 			"Names":        "foobar_baz",
 			"Networks":     "",
 			"Ports":        "",
+			"RestartCount": "",
 			"RunningFor":   "About a minute ago",
 			"Size":         "0B",
 			"State":        "running",
@@ -362,14 +406,17 @@ func TestContainerContextWriteJSON(t *testing.T) {
 		{
 			"Command":      "\"\"",
 			"CreatedAt":    expectedCreated,
+			"Health":       "",
 			"ID":           "containerID2",
 			"Image":        "ubuntu",
 			"Labels":       "",
+			"Links":        "",
 			"LocalVolumes": "0",
 			"Mounts":       "",
 			"Names":        "foobar_bar",
 			"Networks":     "",
 			"Ports":        "",
+			"RestartCount": "",
 			"RunningFor":   "About a minute ago",
 			"Size":         "0B",
 			"State":        "running",
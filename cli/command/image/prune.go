@@ -10,12 +10,14 @@ import (
 	"github.com/docker/cli/cli/command"
 	"github.com/docker/cli/cli/command/completion"
 	"github.com/docker/cli/opts"
+	"github.com/docker/docker/api/types/filters"
 	units "github.com/docker/go-units"
 	"github.com/spf13/cobra"
 )
 
 type pruneOptions struct {
 	force  bool
+	yes    bool
 	all    bool
 	filter opts.FilterOpt
 }
@@ -45,6 +47,7 @@ func NewPruneCommand(dockerCli command.Cli) *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.BoolVarP(&options.force, "force", "f", false, "Do not prompt for confirmation")
+	flags.BoolVar(&options.yes, "yes", false, "Do not prompt for confirmation when using a protected context")
 	flags.BoolVarP(&options.all, "all", "a", false, "Remove all unused images, not just dangling ones")
 	flags.Var(&options.filter, "filter", `Provide filter values (e.g. "until=<timestamp>")`)
 
@@ -59,18 +62,32 @@ Are you sure you want to continue?`
 )
 
 func runPrune(ctx context.Context, dockerCli command.Cli, options pruneOptions) (spaceReclaimed uint64, output string, err error) {
-	pruneFilters := options.filter.Value().Clone()
-	pruneFilters.Add("dangling", strconv.FormatBool(!options.all))
-	pruneFilters = command.PruneFilters(dockerCli, pruneFilters)
+	pruneFilters := imagePruneFilters(dockerCli, options.all, options.filter)
 
 	warning := danglingWarning
 	if options.all {
 		warning = allImageWarning
 	}
-	if !options.force && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), warning) {
+
+	// Protected contexts require confirmation, even if --force is set.
+	if !options.yes && command.IsProtectedContext(dockerCli) {
+		if !command.ConfirmProtectedContext(dockerCli, warning) {
+			return 0, "", nil
+		}
+	} else if !options.force && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), warning) {
 		return 0, "", nil
 	}
 
+	return prune(ctx, dockerCli, pruneFilters)
+}
+
+func imagePruneFilters(dockerCli command.Cli, all bool, filter opts.FilterOpt) filters.Args {
+	pruneFilters := filter.Value().Clone()
+	pruneFilters.Add("dangling", strconv.FormatBool(!all))
+	return command.PruneFilters(dockerCli, pruneFilters)
+}
+
+func prune(ctx context.Context, dockerCli command.Cli, pruneFilters filters.Args) (spaceReclaimed uint64, output string, err error) {
 	report, err := dockerCli.Client().ImagesPrune(ctx, pruneFilters)
 	if err != nil {
 		return 0, "", err
@@ -98,7 +115,9 @@ func runPrune(ctx context.Context, dockerCli command.Cli, options pruneOptions)
 }
 
 // RunPrune calls the Image Prune API
-// This returns the amount of space reclaimed and a detailed output string
+// This returns the amount of space reclaimed and a detailed output string.
+// It does not prompt for confirmation; callers must confirm the operation,
+// including for protected contexts.
 func RunPrune(ctx context.Context, dockerCli command.Cli, all bool, filter opts.FilterOpt) (uint64, string, error) {
-	return runPrune(ctx, dockerCli, pruneOptions{force: true, all: all, filter: filter})
+	return prune(ctx, dockerCli, imagePruneFilters(dockerCli, all, filter))
 }
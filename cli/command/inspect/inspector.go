@@ -43,6 +43,10 @@ func NewTemplateInspector(outputStream io.Writer, tmpl *template.Template) Inspe
 // NewTemplateInspectorFromString creates a new TemplateInspector from a string
 // which is compiled into a template.
 func NewTemplateInspectorFromString(out io.Writer, tmplStr string) (Inspector, error) {
+	return newTemplateInspectorFromString(out, tmplStr, nil)
+}
+
+func newTemplateInspectorFromString(out io.Writer, tmplStr string, funcs template.FuncMap) (Inspector, error) {
 	if tmplStr == "" {
 		return NewIndentedInspector(out), nil
 	}
@@ -51,7 +55,7 @@ func NewTemplateInspectorFromString(out io.Writer, tmplStr string) (Inspector, e
 		return NewJSONInspector(out), nil
 	}
 
-	tmpl, err := templates.Parse(tmplStr)
+	tmpl, err := templates.New("").Funcs(funcs).Parse(tmplStr)
 	if err != nil {
 		return nil, errors.Errorf("template parsing error: %s", err)
 	}
@@ -65,7 +69,13 @@ type GetRefFunc func(ref string) (any, []byte, error)
 // Inspect fetches objects by reference using GetRefFunc and writes the json
 // representation to the output writer.
 func Inspect(out io.Writer, references []string, tmplStr string, getRef GetRefFunc) error {
-	inspector, err := NewTemplateInspectorFromString(out, tmplStr)
+	return InspectWithFuncs(out, references, tmplStr, getRef, nil)
+}
+
+// InspectWithFuncs is like Inspect, but makes the given functions available
+// to the template, in addition to the basic template functions.
+func InspectWithFuncs(out io.Writer, references []string, tmplStr string, getRef GetRefFunc, funcs template.FuncMap) error {
+	inspector, err := newTemplateInspectorFromString(out, tmplStr, funcs)
 	if err != nil {
 		return cli.StatusError{StatusCode: 64, Status: err.Error()}
 	}
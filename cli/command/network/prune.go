@@ -7,11 +7,13 @@ import (
 	"github.com/docker/cli/cli"
 	"github.com/docker/cli/cli/command"
 	"github.com/docker/cli/opts"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/spf13/cobra"
 )
 
 type pruneOptions struct {
 	force  bool
+	yes    bool
 	filter opts.FilterOpt
 }
 
@@ -38,6 +40,7 @@ func NewPruneCommand(dockerCli command.Cli) *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.BoolVarP(&options.force, "force", "f", false, "Do not prompt for confirmation")
+	flags.BoolVar(&options.yes, "yes", false, "Do not prompt for confirmation when using a protected context")
 	flags.Var(&options.filter, "filter", `Provide filter values (e.g. "until=<timestamp>")`)
 
 	return cmd
@@ -49,10 +52,19 @@ Are you sure you want to continue?`
 func runPrune(ctx context.Context, dockerCli command.Cli, options pruneOptions) (output string, err error) {
 	pruneFilters := command.PruneFilters(dockerCli, options.filter.Value())
 
-	if !options.force && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), warning) {
+	// Protected contexts require confirmation, even if --force is set.
+	if !options.yes && command.IsProtectedContext(dockerCli) {
+		if !command.ConfirmProtectedContext(dockerCli, warning) {
+			return "", nil
+		}
+	} else if !options.force && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), warning) {
 		return "", nil
 	}
 
+	return prune(ctx, dockerCli, pruneFilters)
+}
+
+func prune(ctx context.Context, dockerCli command.Cli, pruneFilters filters.Args) (output string, err error) {
 	report, err := dockerCli.Client().NetworksPrune(ctx, pruneFilters)
 	if err != nil {
 		return "", err
@@ -69,8 +81,10 @@ func runPrune(ctx context.Context, dockerCli command.Cli, options pruneOptions)
 }
 
 // RunPrune calls the Network Prune API
-// This returns the amount of space reclaimed and a detailed output string
+// This returns the amount of space reclaimed and a detailed output string.
+// It does not prompt for confirmation; callers must confirm the operation,
+// including for protected contexts.
 func RunPrune(ctx context.Context, dockerCli command.Cli, _ bool, filter opts.FilterOpt) (uint64, string, error) {
-	output, err := runPrune(ctx, dockerCli, pruneOptions{force: true, filter: filter})
+	output, err := prune(ctx, dockerCli, command.PruneFilters(dockerCli, filter.Value()))
 	return 0, output, err
 }
@@ -0,0 +1,91 @@
+package network
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/docker/cli/cli/command"
+	"github.com/docker/cli/cli/context/docker"
+	"github.com/docker/cli/cli/context/store"
+	"github.com/docker/cli/cli/streams"
+	"github.com/docker/cli/internal/test"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+)
+
+func TestNetworkPruneProtectedContext(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		args           []string
+		input          string
+		expectedPrune  bool
+		expectedPrompt bool
+	}{
+		{
+			name:           "force still prompts",
+			args:           []string{"--force"},
+			input:          "n\n",
+			expectedPrompt: true,
+		},
+		{
+			name:           "confirmed",
+			args:           []string{"--force"},
+			input:          "y\n",
+			expectedPrompt: true,
+			expectedPrune:  true,
+		},
+		{
+			name:          "with --yes",
+			args:          []string{"--force", "--yes"},
+			expectedPrune: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var pruned bool
+			fakeCli := test.NewFakeCli(&fakeClient{
+				networkPruneFunc: func(context.Context, filters.Args) (types.NetworksPruneReport, error) {
+					pruned = true
+					return types.NetworksPruneReport{}, nil
+				},
+			})
+			setProtectedContext(t, fakeCli)
+			fakeCli.SetIn(streams.NewIn(io.NopCloser(strings.NewReader(tc.input))))
+			cmd := NewPruneCommand(fakeCli)
+			cmd.SetOut(io.Discard)
+			cmd.SetArgs(tc.args)
+			assert.NilError(t, cmd.Execute())
+			assert.Check(t, is.Equal(pruned, tc.expectedPrune))
+
+			const prompt = `WARNING! Context "production" is protected.
+This will remove all custom networks not used by at least one container.
+Are you sure you want to continue? [y/N] `
+			assert.Check(t, is.Equal(strings.HasPrefix(fakeCli.OutBuffer().String(), prompt), tc.expectedPrompt))
+		})
+	}
+}
+
+// setProtectedContext configures fakeCli to use a protected context named
+// "production".
+func setProtectedContext(t *testing.T, fakeCli *test.FakeCli) {
+	t.Helper()
+	dockerContext := command.DockerContext{}
+	dockerContext.SetProtected(true)
+	contextStore := store.New(t.TempDir(), store.NewConfig(
+		func() any { return &command.DockerContext{} },
+		store.EndpointTypeGetter(docker.DockerEndpoint, func() any { return &docker.EndpointMeta{} }),
+	))
+	assert.NilError(t, contextStore.CreateOrUpdate(store.Metadata{
+		Name:     "production",
+		Metadata: dockerContext,
+		Endpoints: map[string]any{
+			docker.DockerEndpoint: docker.EndpointMeta{Host: "unix:///var/run/docker.sock"},
+		},
+	}))
+	fakeCli.SetContextStore(contextStore)
+	fakeCli.SetCurrentContext("production")
+}
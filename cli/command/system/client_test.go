@@ -5,6 +5,7 @@ import (
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/client"
 )
 
@@ -14,6 +15,13 @@ type fakeClient struct {
 	version       string
 	serverVersion func(ctx context.Context) (types.Version, error)
 	eventsFn      func(context.Context, types.EventsOptions) (<-chan events.Message, <-chan error)
+
+	containerInspectFunc func(ref string, getSize bool) (types.ContainerJSON, []byte, error)
+	imageInspectFunc     func(ref string) (types.ImageInspect, []byte, error)
+
+	containersPruneFunc func(pruneFilters filters.Args) (types.ContainersPruneReport, error)
+	networksPruneFunc   func(pruneFilters filters.Args) (types.NetworksPruneReport, error)
+	imagesPruneFunc     func(pruneFilters filters.Args) (types.ImagesPruneReport, error)
 }
 
 func (cli *fakeClient) ServerVersion(ctx context.Context) (types.Version, error) {
@@ -27,3 +35,23 @@ func (cli *fakeClient) ClientVersion() string {
 func (cli *fakeClient) Events(ctx context.Context, opts types.EventsOptions) (<-chan events.Message, <-chan error) {
 	return cli.eventsFn(ctx, opts)
 }
+
+func (cli *fakeClient) ContainerInspectWithRaw(_ context.Context, ref string, getSize bool) (types.ContainerJSON, []byte, error) {
+	return cli.containerInspectFunc(ref, getSize)
+}
+
+func (cli *fakeClient) ImageInspectWithRaw(_ context.Context, ref string) (types.ImageInspect, []byte, error) {
+	return cli.imageInspectFunc(ref)
+}
+
+func (cli *fakeClient) ContainersPrune(_ context.Context, pruneFilters filters.Args) (types.ContainersPruneReport, error) {
+	return cli.containersPruneFunc(pruneFilters)
+}
+
+func (cli *fakeClient) NetworksPrune(_ context.Context, pruneFilters filters.Args) (types.NetworksPruneReport, error) {
+	return cli.networksPruneFunc(pruneFilters)
+}
+
+func (cli *fakeClient) ImagesPrune(_ context.Context, pruneFilters filters.Args) (types.ImagesPruneReport, error) {
+	return cli.imagesPruneFunc(pruneFilters)
+}
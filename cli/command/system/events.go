@@ -18,6 +18,9 @@ import (
 	"github.com/docker/cli/templates"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/events"
+	timetypes "github.com/docker/docker/api/types/time"
+	"github.com/docker/go-units"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -26,6 +29,7 @@ type eventsOptions struct {
 	until  string
 	filter opts.FilterOpt
 	format string
+	human  bool
 }
 
 // NewEventsCommand creates a new cobra.Command for `docker events`
@@ -46,15 +50,19 @@ func NewEventsCommand(dockerCli command.Cli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.StringVar(&options.since, "since", "", "Show all events created since timestamp")
-	flags.StringVar(&options.until, "until", "", "Stream events until this timestamp")
+	flags.StringVar(&options.since, "since", "", `Show all events created since timestamp (e.g. "2013-01-02T13:23:37Z") or relative (e.g. "42m" for 42 minutes)`)
+	flags.StringVar(&options.until, "until", "", `Stream events until this timestamp (e.g. "2013-01-02T13:23:37Z") or relative (e.g. "42m" for 42 minutes)`)
 	flags.VarP(&options.filter, "filter", "f", "Filter output based on conditions provided")
 	flags.StringVar(&options.format, "format", "", flagsHelper.InspectFormatHelp) // using the same flag description as "inspect" commands for now.
+	flags.BoolVar(&options.human, "human", false, "Print relative times, and align the output in columns")
 
 	return cmd
 }
 
 func runEvents(ctx context.Context, dockerCli command.Cli, options *eventsOptions) error {
+	if options.human && options.format != "" {
+		return errors.New("conflicting options: cannot specify both --human and --format")
+	}
 	tmpl, err := makeTemplate(options.format)
 	if err != nil {
 		return cli.StatusError{
@@ -62,10 +70,23 @@ func runEvents(ctx context.Context, dockerCli command.Cli, options *eventsOption
 			Status:     "Error parsing format: " + err.Error(),
 		}
 	}
+
+	// Resolve relative values for --since and --until against the same
+	// reference time, so that the daemon receives absolute timestamps.
+	now := time.Now()
+	since, err := resolveEventsTime("since", options.since, now)
+	if err != nil {
+		return err
+	}
+	until, err := resolveEventsTime("until", options.until, now)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	evts, errs := dockerCli.Client().Events(ctx, types.EventsOptions{
-		Since:   options.since,
-		Until:   options.until,
+		Since:   since,
+		Until:   until,
 		Filters: options.filter.Value(),
 	})
 	defer cancel()
@@ -75,6 +96,10 @@ func runEvents(ctx context.Context, dockerCli command.Cli, options *eventsOption
 	for {
 		select {
 		case event := <-evts:
+			if options.human {
+				humanPrintEvent(out, event, time.Now())
+				continue
+			}
 			if err := handleEvent(out, event, tmpl); err != nil {
 				return err
 			}
@@ -87,6 +112,20 @@ func runEvents(ctx context.Context, dockerCli command.Cli, options *eventsOption
 	}
 }
 
+// resolveEventsTime converts the value of the --since or --until flag, which
+// can be an RFC3339 date/time, a Unix timestamp, or a duration relative to
+// now, to a Unix timestamp.
+func resolveEventsTime(flag, value string, now time.Time) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	ts, err := timetypes.GetTimestamp(value, now)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid value for --%s", flag)
+	}
+	return ts, nil
+}
+
 func handleEvent(out io.Writer, event events.Message, tmpl *template.Template) error {
 	if tmpl == nil {
 		return prettyPrintEvent(out, event)
@@ -108,7 +147,36 @@ func makeTemplate(format string) (*template.Template, error) {
 	}
 	// execute the template on an empty message to validate a bad
 	// template like "{{.badFieldString}}"
-	return tmpl, tmpl.Execute(io.Discard, &events.Message{})
+	return tmpl, tmpl.Execute(io.Discard, &eventContext{})
+}
+
+// eventContext is the context used for rendering an event with a Go template.
+// In addition to the fields of the event, it provides helpers for accessing
+// commonly used attributes.
+type eventContext struct {
+	events.Message
+}
+
+// Name returns the new name of the container for container rename events,
+// and an empty string for other events.
+func (e *eventContext) Name() string {
+	if !isRenameEvent(e.Message) {
+		return ""
+	}
+	return e.Actor.Attributes["name"]
+}
+
+// OldName returns the name of the container before it was renamed for
+// container rename events, and an empty string for other events.
+func (e *eventContext) OldName() string {
+	if !isRenameEvent(e.Message) {
+		return ""
+	}
+	return strings.TrimPrefix(e.Actor.Attributes["oldName"], "/")
+}
+
+func isRenameEvent(event events.Message) bool {
+	return event.Type == events.ContainerEventType && event.Action == events.ActionRename
 }
 
 // rfc3339NanoFixed is similar to time.RFC3339Nano, except it pads nanoseconds
@@ -125,26 +193,64 @@ func prettyPrintEvent(out io.Writer, event events.Message) error {
 		fmt.Fprintf(out, "%s ", time.Unix(event.Time, 0).Format(rfc3339NanoFixed))
 	}
 
-	fmt.Fprintf(out, "%s %s %s", event.Type, event.Action, event.Actor.ID)
+	fmt.Fprintf(out, "%s %s %s%s\n", event.Type, eventAction(event), event.Actor.ID, eventAttributes(event))
+	return nil
+}
+
+// Minimum column widths used for the --human output.
+const (
+	humanTimeWidth   = len("Less than a second ago")
+	humanTypeWidth   = len(events.ContainerEventType)
+	humanActionWidth = len("health_status")
+)
 
-	if len(event.Actor.Attributes) > 0 {
-		var attrs []string
-		var keys []string
-		for k := range event.Actor.Attributes {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
-		for _, k := range keys {
-			v := event.Actor.Attributes[k]
-			attrs = append(attrs, k+"="+v)
-		}
-		fmt.Fprintf(out, " (%s)", strings.Join(attrs, ", "))
+// humanPrintEvent prints the event information with the time of the event
+// relative to now, aligned in columns.
+func humanPrintEvent(out io.Writer, event events.Message, now time.Time) {
+	var created string
+	if event.TimeNano != 0 {
+		created = units.HumanDuration(now.Sub(time.Unix(0, event.TimeNano))) + " ago"
+	} else if event.Time != 0 {
+		created = units.HumanDuration(now.Sub(time.Unix(event.Time, 0))) + " ago"
 	}
-	fmt.Fprint(out, "\n")
-	return nil
+	fmt.Fprintf(out, "%-*s %-*s %-*s %s%s\n",
+		humanTimeWidth, created,
+		humanTypeWidth, event.Type,
+		humanActionWidth, eventAction(event),
+		event.Actor.ID, eventAttributes(event),
+	)
+}
+
+// eventAction returns the action of the event, including the old and new
+// name for container rename events.
+func eventAction(event events.Message) string {
+	if isRenameEvent(event) {
+		ctx := eventContext{Message: event}
+		return fmt.Sprintf("%s (%s -> %s)", event.Action, ctx.OldName(), ctx.Name())
+	}
+	return string(event.Action)
+}
+
+// eventAttributes returns the actor attributes of the event, sorted by key,
+// in " (key=value, ...)" form, or an empty string if there are no attributes.
+func eventAttributes(event events.Message) string {
+	if len(event.Actor.Attributes) == 0 {
+		return ""
+	}
+	var attrs []string
+	var keys []string
+	for k := range event.Actor.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := event.Actor.Attributes[k]
+		attrs = append(attrs, k+"="+v)
+	}
+	return " (" + strings.Join(attrs, ", ") + ")"
 }
 
 func formatEvent(out io.Writer, event events.Message, tmpl *template.Template) error {
 	defer out.Write([]byte{'\n'})
-	return tmpl.Execute(out, event)
+	return tmpl.Execute(out, &eventContext{Message: event})
 }
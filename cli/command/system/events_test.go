@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/events"
 	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
 	"gotest.tools/v3/golden"
 )
 
@@ -81,3 +83,192 @@ func TestEventsFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestEventsFormatRename(t *testing.T) {
+	evts := []events.Message{
+		{
+			Type:   events.ContainerEventType,
+			Action: events.ActionCreate,
+			Actor: events.Actor{
+				ID:         "abc123",
+				Attributes: map[string]string{"image": "ubuntu:latest", "name": "first_name"},
+			},
+			Scope:    "local",
+			Time:     1,
+			TimeNano: int64(time.Second),
+		},
+		{
+			Type:   events.ContainerEventType,
+			Action: events.ActionRename,
+			Actor: events.Actor{
+				ID:         "abc123",
+				Attributes: map[string]string{"image": "ubuntu:latest", "name": "new_name", "oldName": "/first_name"},
+			},
+			Scope:    "local",
+			Time:     2,
+			TimeNano: 2 * int64(time.Second),
+		},
+		{
+			Type:   events.ContainerEventType,
+			Action: events.ActionDie,
+			Actor: events.Actor{
+				ID:         "abc123",
+				Attributes: map[string]string{"exitCode": "0", "image": "ubuntu:latest", "name": "new_name"},
+			},
+			Scope:    "local",
+			Time:     3,
+			TimeNano: 3 * int64(time.Second),
+		},
+	}
+	tests := []struct {
+		name, format string
+	}{
+		{
+			name: "default",
+		},
+		{
+			name:   "names",
+			format: "{{ .Action }}: {{ .OldName }} -> {{ .Name }}",
+		},
+		{
+			name:   "json",
+			format: "json",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// Set to UTC timezone as timestamps in output are
+			// printed in the current timezone
+			t.Setenv("TZ", "UTC")
+			cli := test.NewFakeCli(&fakeClient{eventsFn: func(context.Context, types.EventsOptions) (<-chan events.Message, <-chan error) {
+				messages := make(chan events.Message)
+				errs := make(chan error, 1)
+				go func() {
+					for _, msg := range evts {
+						messages <- msg
+					}
+					errs <- io.EOF
+				}()
+				return messages, errs
+			}})
+			cmd := NewEventsCommand(cli)
+			if tc.format != "" {
+				cmd.Flags().Set("format", tc.format)
+			}
+			assert.Check(t, cmd.Execute())
+			out := cli.OutBuffer().String()
+			assert.Check(t, golden.String(out, fmt.Sprintf("docker-events-rename-%s.golden", tc.name)))
+		})
+	}
+}
+
+func TestResolveEventsTime(t *testing.T) {
+	now := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		value       string
+		expected    string
+		expectedErr string
+	}{
+		{value: "", expected: ""},
+		{value: "10m", expected: "1136213645"},
+		{value: "1h30m", expected: "1136208845"},
+		{value: "-5m", expected: "1136214545"},
+		{value: "2006-01-02T15:00:00Z", expected: "1136214000.000000000"},
+		{value: "2006-01-02T17:00:00+02:00", expected: "1136214000.000000000"},
+		{value: "2006-01-02T15:00:00.5Z", expected: "1136214000.500000000"},
+		{value: "2006-01-02Z", expected: "1136160000.000000000"},
+		{value: "1136214000", expected: "1136214000"},
+		{value: "1136214000.000000001", expected: "1136214000.000000001"},
+		{value: "yesterday", expectedErr: `invalid value for --since: failed to parse value as time or duration: "yesterday"`},
+		{value: "2006-13-02T15:00:00Z", expectedErr: "invalid value for --since: "},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.value, func(t *testing.T) {
+			actual, err := resolveEventsTime("since", tc.value, now)
+			if tc.expectedErr != "" {
+				assert.Check(t, is.ErrorContains(err, tc.expectedErr))
+				return
+			}
+			assert.NilError(t, err)
+			assert.Check(t, is.Equal(actual, tc.expected))
+		})
+	}
+}
+
+func TestEventsSinceUntil(t *testing.T) {
+	var options types.EventsOptions
+	cli := test.NewFakeCli(&fakeClient{eventsFn: func(_ context.Context, opts types.EventsOptions) (<-chan events.Message, <-chan error) {
+		options = opts
+		errs := make(chan error, 1)
+		errs <- io.EOF
+		return nil, errs
+	}})
+	cmd := NewEventsCommand(cli)
+	cmd.SetArgs([]string{"--since", "10m", "--until", "1136214000"})
+	before := time.Now()
+	assert.NilError(t, cmd.Execute())
+
+	since, err := strconv.ParseInt(options.Since, 10, 64)
+	assert.NilError(t, err, "expected --since to be resolved to a Unix timestamp, got %q", options.Since)
+	assert.Check(t, since >= before.Add(-10*time.Minute).Unix()-1 && since <= time.Now().Add(-10*time.Minute).Unix())
+	assert.Check(t, is.Equal(options.Until, "1136214000"))
+}
+
+func TestEventsErrors(t *testing.T) {
+	tests := []struct {
+		args        []string
+		expectedErr string
+	}{
+		{args: []string{"--since", "yesterday"}, expectedErr: "invalid value for --since"},
+		{args: []string{"--until", "tomorrow"}, expectedErr: "invalid value for --until"},
+		{args: []string{"--human", "--format", "json"}, expectedErr: "conflicting options: cannot specify both --human and --format"},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+			cmd := NewEventsCommand(test.NewFakeCli(&fakeClient{}))
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			cmd.SetArgs(tc.args)
+			assert.ErrorContains(t, cmd.Execute(), tc.expectedErr)
+		})
+	}
+}
+
+func TestEventsHuman(t *testing.T) {
+	now := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	evts := []events.Message{
+		{
+			Type:     events.ContainerEventType,
+			Action:   events.ActionCreate,
+			Actor:    events.Actor{ID: "abc123", Attributes: map[string]string{"image": "ubuntu:latest", "name": "first_name"}},
+			TimeNano: now.Add(-3 * time.Minute).UnixNano(),
+		},
+		{
+			Type:     events.ContainerEventType,
+			Action:   events.ActionRename,
+			Actor:    events.Actor{ID: "abc123", Attributes: map[string]string{"name": "new_name", "oldName": "/first_name"}},
+			TimeNano: now.Add(-90 * time.Second).UnixNano(),
+		},
+		{
+			Type:   events.NetworkEventType,
+			Action: events.ActionConnect,
+			Actor:  events.Actor{ID: "def456", Attributes: map[string]string{"container": "abc123", "name": "bridge"}},
+			Time:   now.Add(-2 * time.Hour).Unix(),
+		},
+		{
+			Type:     events.DaemonEventType,
+			Action:   events.ActionReload,
+			Actor:    events.Actor{ID: "daemon-id"},
+			TimeNano: now.UnixNano(),
+		},
+	}
+	out := new(strings.Builder)
+	for _, event := range evts {
+		humanPrintEvent(out, event, now)
+	}
+	golden.Assert(t, out.String(), "docker-events-human.golden")
+}
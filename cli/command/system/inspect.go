@@ -10,6 +10,7 @@ import (
 
 	"github.com/docker/cli/cli"
 	"github.com/docker/cli/cli/command"
+	"github.com/docker/cli/cli/command/container"
 	"github.com/docker/cli/cli/command/inspect"
 	flagsHelper "github.com/docker/cli/cli/flags"
 	"github.com/docker/docker/api/types"
@@ -55,7 +56,7 @@ func runInspect(ctx context.Context, dockerCli command.Cli, opts inspectOptions)
 	default:
 		return errors.Errorf("%q is not a valid value for --type", opts.inspectType)
 	}
-	return inspect.Inspect(dockerCli.Out(), opts.ids, opts.format, elementSearcher)
+	return inspect.InspectWithFuncs(dockerCli.Out(), opts.ids, opts.format, elementSearcher, container.InspectTemplateFuncs(ctx, dockerCli.Client()))
 }
 
 func inspectContainers(ctx context.Context, dockerCli command.Cli, getSize bool) inspect.GetRefFunc {
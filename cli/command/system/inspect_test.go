@@ -0,0 +1,42 @@
+package system
+
+import (
+	"io"
+	"testing"
+
+	"github.com/docker/cli/internal/test"
+	"github.com/docker/docker/api/types"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+)
+
+func TestInspectSize(t *testing.T) {
+	var sizeRequested bool
+	cli := test.NewFakeCli(&fakeClient{
+		containerInspectFunc: func(ref string, getSize bool) (types.ContainerJSON, []byte, error) {
+			sizeRequested = getSize
+			return types.ContainerJSON{ContainerJSONBase: &types.ContainerJSONBase{ID: "web-id", Name: "/" + ref}}, nil, nil
+		},
+	})
+	cmd := NewInspectCommand(cli)
+	cmd.SetOut(io.Discard)
+	cmd.SetArgs([]string{"--type", "container", "--size", "--format", "{{.ID}}", "web"})
+	assert.NilError(t, cmd.Execute())
+	assert.Check(t, sizeRequested)
+	assert.Check(t, is.Equal(cli.OutBuffer().String(), "web-id\n"))
+	assert.Check(t, is.Equal(cli.ErrBuffer().String(), ""))
+}
+
+func TestInspectSizeIgnoredForNonContainers(t *testing.T) {
+	cli := test.NewFakeCli(&fakeClient{
+		imageInspectFunc: func(string) (types.ImageInspect, []byte, error) {
+			return types.ImageInspect{ID: "sha256:busybox"}, nil, nil
+		},
+	})
+	cmd := NewInspectCommand(cli)
+	cmd.SetOut(io.Discard)
+	cmd.SetArgs([]string{"--type", "image", "--size", "--format", "{{.ID}}", "busybox"})
+	assert.NilError(t, cmd.Execute())
+	assert.Check(t, is.Equal(cli.OutBuffer().String(), "sha256:busybox\n"))
+	assert.Check(t, is.Equal(cli.ErrBuffer().String(), "WARNING: --size ignored for image\n"))
+}
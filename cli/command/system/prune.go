@@ -24,6 +24,7 @@ import (
 
 type pruneOptions struct {
 	force           bool
+	yes             bool
 	all             bool
 	pruneVolumes    bool
 	pruneBuildCache bool
@@ -48,6 +49,7 @@ func newPruneCommand(dockerCli command.Cli) *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.BoolVarP(&options.force, "force", "f", false, "Do not prompt for confirmation")
+	flags.BoolVar(&options.yes, "yes", false, "Do not prompt for confirmation when using a protected context")
 	flags.BoolVarP(&options.all, "all", "a", false, "Remove all unused images not just dangling ones")
 	flags.BoolVar(&options.pruneVolumes, "volumes", false, "Prune anonymous volumes")
 	flags.Var(&options.filter, "filter", `Provide filter values (e.g. "label=<key>=<value>")`)
@@ -74,7 +76,12 @@ func runPrune(ctx context.Context, dockerCli command.Cli, options pruneOptions)
 	if options.pruneVolumes && options.filter.Value().Contains("until") {
 		return fmt.Errorf(`ERROR: The "until" filter is not supported with "--volumes"`)
 	}
-	if !options.force && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), confirmationMessage(dockerCli, options)) {
+	// Protected contexts require confirmation, even if --force is set.
+	if !options.yes && command.IsProtectedContext(dockerCli) {
+		if !command.ConfirmProtectedContext(dockerCli, confirmationMessage(dockerCli, options)) {
+			return nil
+		}
+	} else if !options.force && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), confirmationMessage(dockerCli, options)) {
 		return nil
 	}
 	pruneFuncs := []func(ctx context.Context, dockerCli command.Cli, all bool, filter opts.FilterOpt) (uint64, string, error){
@@ -1,10 +1,18 @@
 package system
 
 import (
+	"io"
+	"strings"
 	"testing"
 
+	"github.com/docker/cli/cli/command"
 	"github.com/docker/cli/cli/config/configfile"
+	"github.com/docker/cli/cli/context/docker"
+	"github.com/docker/cli/cli/context/store"
+	"github.com/docker/cli/cli/streams"
 	"github.com/docker/cli/internal/test"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
 	"gotest.tools/v3/assert"
 	is "gotest.tools/v3/assert/cmp"
 )
@@ -49,3 +57,93 @@ func TestPrunePromptFilters(t *testing.T) {
 Are you sure you want to continue? [y/N] `
 	assert.Check(t, is.Equal(expected, cli.OutBuffer().String()))
 }
+
+func TestPruneProtectedContext(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		args           []string
+		input          string
+		expectedPrune  bool
+		expectedPrompt bool
+	}{
+		{
+			name:           "force still prompts",
+			args:           []string{"--force"},
+			input:          "n\n",
+			expectedPrompt: true,
+		},
+		{
+			name:           "confirmed",
+			args:           []string{"--force"},
+			input:          "y\n",
+			expectedPrompt: true,
+			expectedPrune:  true,
+		},
+		{
+			name:          "with --yes",
+			args:          []string{"--force", "--yes"},
+			expectedPrune: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var pruned []string
+			fakeCli := test.NewFakeCli(&fakeClient{
+				version: "1.30",
+				containersPruneFunc: func(filters.Args) (types.ContainersPruneReport, error) {
+					pruned = append(pruned, "containers")
+					return types.ContainersPruneReport{}, nil
+				},
+				networksPruneFunc: func(filters.Args) (types.NetworksPruneReport, error) {
+					pruned = append(pruned, "networks")
+					return types.NetworksPruneReport{}, nil
+				},
+				imagesPruneFunc: func(filters.Args) (types.ImagesPruneReport, error) {
+					pruned = append(pruned, "images")
+					return types.ImagesPruneReport{}, nil
+				},
+			})
+			setProtectedContext(t, fakeCli)
+			fakeCli.SetIn(streams.NewIn(io.NopCloser(strings.NewReader(tc.input))))
+			cmd := newPruneCommand(fakeCli)
+			cmd.SetOut(io.Discard)
+			cmd.SetArgs(tc.args)
+			assert.NilError(t, cmd.Execute())
+
+			if tc.expectedPrune {
+				assert.Check(t, is.DeepEqual(pruned, []string{"containers", "networks", "images"}))
+			} else {
+				assert.Check(t, is.Len(pruned, 0))
+			}
+			const prompt = `WARNING! Context "production" is protected.
+This will remove:
+  - all stopped containers
+  - all networks not used by at least one container
+  - all dangling images
+
+Are you sure you want to continue? [y/N] `
+			assert.Check(t, is.Equal(strings.HasPrefix(fakeCli.OutBuffer().String(), prompt), tc.expectedPrompt))
+		})
+	}
+}
+
+// setProtectedContext configures fakeCli to use a protected context named
+// "production".
+func setProtectedContext(t *testing.T, fakeCli *test.FakeCli) {
+	t.Helper()
+	dockerContext := command.DockerContext{}
+	dockerContext.SetProtected(true)
+	contextStore := store.New(t.TempDir(), store.NewConfig(
+		func() any { return &command.DockerContext{} },
+		store.EndpointTypeGetter(docker.DockerEndpoint, func() any { return &docker.EndpointMeta{} }),
+	))
+	assert.NilError(t, contextStore.CreateOrUpdate(store.Metadata{
+		Name:     "production",
+		Metadata: dockerContext,
+		Endpoints: map[string]any{
+			docker.DockerEndpoint: docker.EndpointMeta{Host: "unix:///var/run/docker.sock"},
+		},
+	}))
+	fakeCli.SetContextStore(contextStore)
+	fakeCli.SetCurrentContext("production")
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/docker/cli/cli/command"
 	"github.com/docker/cli/cli/command/completion"
 	"github.com/docker/cli/opts"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/versions"
 	"github.com/docker/docker/errdefs"
 	units "github.com/docker/go-units"
@@ -18,6 +19,7 @@ import (
 type pruneOptions struct {
 	all    bool
 	force  bool
+	yes    bool
 	filter opts.FilterOpt
 }
 
@@ -52,6 +54,7 @@ func NewPruneCommand(dockerCli command.Cli) *cobra.Command {
 	flags.BoolVarP(&options.all, "all", "a", false, "Remove all unused volumes, not just anonymous ones")
 	flags.SetAnnotation("all", "version", []string{"1.42"})
 	flags.BoolVarP(&options.force, "force", "f", false, "Do not prompt for confirmation")
+	flags.BoolVar(&options.yes, "yes", false, "Do not prompt for confirmation when using a protected context")
 	flags.Var(&options.filter, "filter", `Provide filter values (e.g. "label=<label>")`)
 
 	return cmd
@@ -80,10 +83,20 @@ func runPrune(ctx context.Context, dockerCli command.Cli, options pruneOptions)
 		// API < v1.42 removes all volumes (anonymous and named) by default.
 		warning = allVolumesWarning
 	}
-	if !options.force && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), warning) {
+
+	// Protected contexts require confirmation, even if --force is set.
+	if !options.yes && command.IsProtectedContext(dockerCli) {
+		if !command.ConfirmProtectedContext(dockerCli, warning) {
+			return 0, "", errdefs.Cancelled(errors.New("user cancelled operation"))
+		}
+	} else if !options.force && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), warning) {
 		return 0, "", errdefs.Cancelled(errors.New("user cancelled operation"))
 	}
 
+	return prune(ctx, dockerCli, pruneFilters)
+}
+
+func prune(ctx context.Context, dockerCli command.Cli, pruneFilters filters.Args) (spaceReclaimed uint64, output string, err error) {
 	report, err := dockerCli.Client().VolumesPrune(ctx, pruneFilters)
 	if err != nil {
 		return 0, "", err
@@ -101,7 +114,9 @@ func runPrune(ctx context.Context, dockerCli command.Cli, options pruneOptions)
 }
 
 // RunPrune calls the Volume Prune API
-// This returns the amount of space reclaimed and a detailed output string
+// This returns the amount of space reclaimed and a detailed output string.
+// It does not prompt for confirmation; callers must confirm the operation,
+// including for protected contexts.
 func RunPrune(ctx context.Context, dockerCli command.Cli, _ bool, filter opts.FilterOpt) (uint64, string, error) {
-	return runPrune(ctx, dockerCli, pruneOptions{force: true, filter: filter})
+	return prune(ctx, dockerCli, command.PruneFilters(dockerCli, filter.Value()))
 }
@@ -0,0 +1,53 @@
+package container
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/docker/cli/e2e/internal/fixtures"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/icmd"
+)
+
+func TestInspectLinks(t *testing.T) {
+	result := icmd.RunCommand("docker", "run", "-d", "--name", "db1", fixtures.AlpineImage, "top")
+	result.Assert(t, icmd.Success)
+	dbID := strings.TrimSpace(result.Stdout())
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "rm", "-f", "db1")
+	})
+
+	result = icmd.RunCommand("docker", "create", "--name", "app1", "--link", "db1:mysql", fixtures.AlpineImage, "true")
+	result.Assert(t, icmd.Success)
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "rm", "-f", "app1")
+	})
+
+	result = icmd.RunCommand("docker", "inspect", "--format", "{{range links .}}{{.Alias}}={{.Target}}:{{.TargetID}}{{end}}", "app1")
+	result.Assert(t, icmd.Success)
+	assert.Check(t, is.Equal(strings.TrimSpace(result.Stdout()), "mysql=db1:"+dbID))
+}
+
+func TestInspectSize(t *testing.T) {
+	result := icmd.RunCommand("docker", "run", "--name", "inspect-size", fixtures.AlpineImage, "sh", "-c", "head -c 4096 /dev/zero > /data")
+	result.Assert(t, icmd.Success)
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "rm", "-f", "inspect-size")
+	})
+
+	result = icmd.RunCommand("docker", "container", "inspect", "--format", "{{json .SizeRw}} {{json .SizeRootFs}}", "inspect-size")
+	result.Assert(t, icmd.Success)
+	assert.Check(t, is.Equal(strings.TrimSpace(result.Stdout()), "null null"))
+
+	result = icmd.RunCommand("docker", "container", "inspect", "--size", "--format", "{{.SizeRw}}", "inspect-size")
+	result.Assert(t, icmd.Success)
+	sizeRw, err := strconv.ParseInt(strings.TrimSpace(result.Stdout()), 10, 64)
+	assert.NilError(t, err)
+	assert.Check(t, sizeRw >= 4096, "expected SizeRw to include the written data, got %d", sizeRw)
+
+	result = icmd.RunCommand("docker", "container", "inspect", "--size", "--format", "{{json .SizeRootFs}}", "inspect-size")
+	result.Assert(t, icmd.Success)
+	assert.Check(t, strings.TrimSpace(result.Stdout()) != "null")
+}
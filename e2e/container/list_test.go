@@ -0,0 +1,126 @@
+package container
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/cli/e2e/internal/fixtures"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/icmd"
+	"gotest.tools/v3/poll"
+)
+
+func TestListFilterNameExact(t *testing.T) {
+	for _, name := range []string{"ps-name-exact", "ps-name-exact2"} {
+		name := name
+		result := icmd.RunCommand("docker", "create", "--name", name, fixtures.AlpineImage, "true")
+		result.Assert(t, icmd.Success)
+		t.Cleanup(func() {
+			icmd.RunCommand("docker", "rm", "-f", name)
+		})
+	}
+
+	result := icmd.RunCommand("docker", "ps", "-a", "--filter", "name=ps-name-exact", "--format", "{{.Names}}")
+	result.Assert(t, icmd.Success)
+	assert.Check(t, is.DeepEqual(sortedLines(result.Stdout()), []string{"ps-name-exact", "ps-name-exact2"}))
+
+	result = icmd.RunCommand("docker", "ps", "-a", "--filter", "name-exact=ps-name-exact", "--format", "{{.Names}}")
+	result.Assert(t, icmd.Success)
+	assert.Check(t, is.DeepEqual(sortedLines(result.Stdout()), []string{"ps-name-exact"}))
+
+	result = icmd.RunCommand("docker", "ps", "-a", "--filter", "name-exact=ps-name", "--format", "{{.Names}}")
+	result.Assert(t, icmd.Success)
+	assert.Check(t, is.Equal(strings.TrimSpace(result.Stdout()), ""))
+}
+
+func TestListFilterHealth(t *testing.T) {
+	for name, cmd := range map[string]string{
+		"ps-health-healthy":   "true",
+		"ps-health-unhealthy": "false",
+	} {
+		name := name
+		result := icmd.RunCommand("docker", "run", "-d", "--name", name,
+			"--health-cmd", cmd, "--health-interval", "1s", "--health-retries", "1",
+			fixtures.AlpineImage, "top")
+		result.Assert(t, icmd.Success)
+		t.Cleanup(func() {
+			icmd.RunCommand("docker", "rm", "-f", name)
+		})
+	}
+	poll.WaitOn(t, containerWithHealth("ps-health-healthy", "healthy"), poll.WithDelay(100*time.Millisecond), poll.WithTimeout(30*time.Second))
+	poll.WaitOn(t, containerWithHealth("ps-health-unhealthy", "unhealthy"), poll.WithDelay(100*time.Millisecond), poll.WithTimeout(30*time.Second))
+
+	for _, tc := range []struct {
+		health   string
+		expected []string
+	}{
+		{health: "healthy", expected: []string{"ps-health-healthy"}},
+		{health: "unhealthy", expected: []string{"ps-health-unhealthy"}},
+		{health: "starting", expected: []string{}},
+	} {
+		result := icmd.RunCommand("docker", "ps", "--filter", "name=ps-health-", "--filter", "health="+tc.health, "--format", "{{.Names}}")
+		result.Assert(t, icmd.Success)
+		assert.Check(t, is.DeepEqual(sortedLines(result.Stdout()), tc.expected), tc.health)
+	}
+
+	result := icmd.RunCommand("docker", "ps", "--filter", "name=ps-health-", "--format", "{{.Names}}={{.Health}}")
+	result.Assert(t, icmd.Success)
+	assert.Check(t, is.DeepEqual(sortedLines(result.Stdout()), []string{"ps-health-healthy=healthy", "ps-health-unhealthy=unhealthy"}))
+}
+
+func containerWithHealth(name, health string) func(poll.LogT) poll.Result {
+	return func(poll.LogT) poll.Result {
+		result := icmd.RunCommand("docker", "inspect", "-f", "{{ .State.Health.Status }}", name)
+		actual := strings.TrimSpace(result.Stdout())
+		if actual == health {
+			return poll.Success()
+		}
+		return poll.Continue("expected health %s != %s", health, actual)
+	}
+}
+
+func sortedLines(s string) []string {
+	lines := strings.Fields(s)
+	sort.Strings(lines)
+	return lines
+}
+
+func TestListFilterRestarts(t *testing.T) {
+	const name = "ps-restarts-crashloop"
+	result := icmd.RunCommand("docker", "run", "-d", "--name", name,
+		"--restart", "on-failure", fixtures.AlpineImage, "false")
+	result.Assert(t, icmd.Success)
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "rm", "-f", name)
+	})
+	poll.WaitOn(t, containerWithMinRestarts(name, 2), poll.WithDelay(100*time.Millisecond), poll.WithTimeout(60*time.Second))
+
+	result = icmd.RunCommand("docker", "ps", "-a", "--filter", "name="+name, "--filter", "restarts>=2", "--format", "{{.Names}}")
+	result.Assert(t, icmd.Success)
+	assert.Check(t, is.DeepEqual(sortedLines(result.Stdout()), []string{name}))
+
+	result = icmd.RunCommand("docker", "ps", "-a", "--filter", "name="+name, "--filter", "restarts=>1000", "--format", "{{.Names}}")
+	result.Assert(t, icmd.Success)
+	assert.Check(t, is.DeepEqual(sortedLines(result.Stdout()), []string{}))
+
+	result = icmd.RunCommand("docker", "ps", "-a", "--filter", "name="+name, "--format", "{{.RestartCount}}")
+	result.Assert(t, icmd.Success)
+	restartCount, err := strconv.Atoi(strings.TrimSpace(result.Stdout()))
+	assert.NilError(t, err)
+	assert.Check(t, restartCount >= 2)
+}
+
+func containerWithMinRestarts(name string, minRestarts int) func(poll.LogT) poll.Result {
+	return func(poll.LogT) poll.Result {
+		result := icmd.RunCommand("docker", "inspect", "-f", "{{ .RestartCount }}", name)
+		actual, err := strconv.Atoi(strings.TrimSpace(result.Stdout()))
+		if err == nil && actual >= minRestarts {
+			return poll.Success()
+		}
+		return poll.Continue("expected at least %d restarts, got %q", minRestarts, strings.TrimSpace(result.Stdout()))
+	}
+}
@@ -0,0 +1,66 @@
+package container
+
+import (
+	"bufio"
+	"context"
+	"os/exec"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/cli/e2e/internal/fixtures"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/icmd"
+)
+
+func TestLogsFollowStartupMessage(t *testing.T) {
+	const name = "logs-startup-message"
+	result := icmd.RunCommand("docker", "run", "-d", "--name", name, fixtures.AlpineImage,
+		"sh", "-c", "sleep 1; echo 'server started on port 8080'; exec top")
+	result.Assert(t, icmd.Success)
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "rm", "-f", name)
+	})
+
+	groups := waitForLogLine(t, name, `server started on port (\d+)`, 30*time.Second)
+	assert.Check(t, is.DeepEqual(groups, []string{"8080"}))
+}
+
+// waitForLogLine follows the logs of a container until a line matches the
+// given pattern, and returns the capture groups of the match. The test fails,
+// showing the collected logs, if the container exits before a line matches,
+// or if no line matches within the timeout.
+func waitForLogLine(t *testing.T, containerID, pattern string, timeout time.Duration) []string {
+	t.Helper()
+	re := regexp.MustCompile(pattern)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "docker", "logs", "--follow", containerID)
+	stdout, err := cmd.StdoutPipe()
+	assert.NilError(t, err)
+	cmd.Stderr = cmd.Stdout
+	assert.NilError(t, cmd.Start())
+	defer func() {
+		cancel()
+		_ = cmd.Wait()
+	}()
+
+	var logs strings.Builder
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		line := scanner.Text()
+		logs.WriteString(line + "\n")
+		if m := re.FindStringSubmatch(line); m != nil {
+			return m[1:]
+		}
+	}
+	if ctx.Err() != nil {
+		t.Fatalf("timeout waiting for a log line matching %q in container %s; logs:\n%s", pattern, containerID, logs.String())
+	}
+	t.Fatalf("container %s exited before a log line matched %q; logs:\n%s", containerID, pattern, logs.String())
+	return nil
+}
@@ -0,0 +1,215 @@
+package container
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/cli/e2e/internal/fixtures"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/icmd"
+	"gotest.tools/v3/poll"
+)
+
+func TestRenameMultiplePairs(t *testing.T) {
+	first := createRenameTestContainer(t, "rename-multi-a")
+	second := createRenameTestContainer(t, "rename-multi-b")
+
+	result := icmd.RunCommand("docker", "rename",
+		first, first+"-new",
+		"rename-multi-missing", "rename-multi-x",
+		second, second+"-new",
+	)
+	result.Assert(t, icmd.Expected{
+		ExitCode: 1,
+		Err:      "Error: failed to rename container named rename-multi-missing",
+	})
+	defer icmd.RunCommand("docker", "rm", "-f", first+"-new", second+"-new")
+
+	for _, name := range []string{first + "-new", second + "-new"} {
+		result := icmd.RunCommand("docker", "inspect", "-f", "{{ .Name }}", name)
+		result.Assert(t, icmd.Success)
+		if actual := strings.TrimSpace(result.Stdout()); actual != "/"+name {
+			t.Errorf("expected container name /%s, got %s", name, actual)
+		}
+	}
+}
+
+func createRenameTestContainer(t *testing.T, name string) string {
+	t.Helper()
+	result := icmd.RunCommand("docker", "create", "--name", name, fixtures.AlpineImage, "true")
+	result.Assert(t, icmd.Success)
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "rm", "-f", name)
+	})
+	return name
+}
+
+func TestRenamePausedContainer(t *testing.T) {
+	name := runPausedContainer(t, "rename-paused")
+
+	result := icmd.RunCommand("docker", "rename", name, name+"-new")
+	result.Assert(t, icmd.Success)
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "unpause", name+"-new")
+		icmd.RunCommand("docker", "rm", "-f", name+"-new")
+	})
+
+	result = icmd.RunCommand("docker", "inspect", "-f", "{{ .Name }} {{ .State.Status }}", name+"-new")
+	result.Assert(t, icmd.Success)
+	assert.Check(t, is.Equal(strings.TrimSpace(result.Stdout()), "/"+name+"-new paused"))
+}
+
+// runPausedContainer runs a container with the given name and pauses it. The
+// container is unpaused before it is removed on cleanup, so that removing it
+// does not hang.
+func runPausedContainer(t *testing.T, name string) string {
+	t.Helper()
+	result := icmd.RunCommand("docker", "run", "-d", "--name", name, fixtures.AlpineImage, "top")
+	result.Assert(t, icmd.Success)
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "unpause", name)
+		icmd.RunCommand("docker", "rm", "-f", name)
+	})
+	icmd.RunCommand("docker", "pause", name).Assert(t, icmd.Success)
+	poll.WaitOn(t, containerStatus(t, name, "paused"), poll.WithDelay(100*time.Millisecond), poll.WithTimeout(10*time.Second))
+	return name
+}
+
+func TestRenameKeepsFilesystemChanges(t *testing.T) {
+	const name = "rename-diff"
+	result := icmd.RunCommand("docker", "run", "-d", "--name", name, fixtures.AlpineImage,
+		"sh", "-c", "echo hello > /rename-diff.txt && exec top")
+	result.Assert(t, icmd.Success)
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "rm", "-f", name, name+"-new")
+	})
+	poll.WaitOn(t, containerHasChange(name, "A /rename-diff.txt"), poll.WithDelay(100*time.Millisecond), poll.WithTimeout(10*time.Second))
+	before := containerChanges(t, name)
+
+	icmd.RunCommand("docker", "rename", name, name+"-new").Assert(t, icmd.Success)
+	assert.Check(t, is.DeepEqual(containerChanges(t, name+"-new"), before))
+
+	const ref = "e2e/rename-diff:latest"
+	icmd.RunCommand("docker", "commit", name+"-new", ref).Assert(t, icmd.Success)
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "rmi", "-f", ref)
+	})
+	result = icmd.RunCommand("docker", "run", "--rm", ref, "cat", "/rename-diff.txt")
+	result.Assert(t, icmd.Expected{Out: "hello"})
+}
+
+// containerChanges returns the sorted output of "docker diff", omitting
+// changes to the shell history, which depend on the image.
+func containerChanges(t *testing.T, name string) []string {
+	t.Helper()
+	result := icmd.RunCommand("docker", "diff", name)
+	result.Assert(t, icmd.Success)
+	var changes []string
+	for _, line := range strings.Split(strings.TrimSpace(result.Stdout()), "\n") {
+		if line == "" || strings.HasSuffix(line, "/.ash_history") {
+			continue
+		}
+		changes = append(changes, line)
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+func containerHasChange(name, change string) func(poll.LogT) poll.Result {
+	return func(poll.LogT) poll.Result {
+		result := icmd.RunCommand("docker", "diff", name)
+		for _, line := range strings.Split(result.Stdout(), "\n") {
+			if strings.TrimSpace(line) == change {
+				return poll.Success()
+			}
+		}
+		return poll.Continue("expected change %q in %q", change, result.Stdout())
+	}
+}
+
+func TestRenameNetworkNamespaceOwner(t *testing.T) {
+	const owner, dependent = "rename-netns-owner", "rename-netns-dependent"
+	result := icmd.RunCommand("docker", "run", "-d", "--name", owner, fixtures.AlpineImage, "top")
+	result.Assert(t, icmd.Success)
+	ownerID := strings.TrimSpace(result.Stdout())
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "rm", "-f", dependent, owner, owner+"-new")
+	})
+	icmd.RunCommand("docker", "run", "-d", "--name", dependent, "--network", "container:"+owner, fixtures.AlpineImage, "top").Assert(t, icmd.Success)
+	assertSameIP(t, owner, dependent)
+
+	icmd.RunCommand("docker", "rename", owner, owner+"-new").Assert(t, icmd.Success)
+
+	result = icmd.RunCommand("docker", "inspect", "-f", "{{ .HostConfig.NetworkMode }}", dependent)
+	result.Assert(t, icmd.Success)
+	assert.Check(t, is.Equal(strings.TrimSpace(result.Stdout()), "container:"+ownerID))
+	assertSameIP(t, owner+"-new", dependent)
+}
+
+// assertSameIP asserts that both containers report the same IP addresses,
+// as is the case when one uses the network namespace of the other.
+func assertSameIP(t *testing.T, a, b string) {
+	t.Helper()
+	resultA := icmd.RunCommand("docker", "exec", a, "hostname", "-i")
+	resultA.Assert(t, icmd.Success)
+	resultB := icmd.RunCommand("docker", "exec", b, "hostname", "-i")
+	resultB.Assert(t, icmd.Success)
+	assert.Check(t, strings.TrimSpace(resultA.Stdout()) != "")
+	assert.Check(t, is.Equal(strings.TrimSpace(resultA.Stdout()), strings.TrimSpace(resultB.Stdout())))
+}
+
+func TestRenameKeepsLabels(t *testing.T) {
+	const name = "rename-labels-web-1"
+	labels := map[string]string{
+		"com.docker.compose.project":          "rename-labels",
+		"com.docker.compose.service":          "web",
+		"com.docker.compose.container-number": "1",
+	}
+	args := []string{"create", "--name", name}
+	for k, v := range labels {
+		args = append(args, "--label", k+"="+v)
+	}
+	icmd.RunCommand("docker", append(args, fixtures.AlpineImage, "true")...).Assert(t, icmd.Success)
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "rm", "-f", name, name+"-new")
+	})
+
+	icmd.RunCommand("docker", "rename", name, name+"-new").Assert(t, icmd.Success)
+
+	for k, v := range labels {
+		result := icmd.RunCommand("docker", "inspect", "-f", "{{ index .Config.Labels \""+k+"\" }}", name+"-new")
+		result.Assert(t, icmd.Success)
+		assert.Check(t, is.Equal(strings.TrimSpace(result.Stdout()), v), k)
+	}
+
+	result := icmd.RunCommand("docker", "ps", "-a",
+		"--filter", "label=com.docker.compose.project=rename-labels",
+		"--filter", "label=com.docker.compose.service=web",
+		"--format", "{{ .Names }}")
+	result.Assert(t, icmd.Success)
+	assert.Check(t, is.DeepEqual(sortedLines(result.Stdout()), []string{name + "-new"}))
+}
+
+func TestRenameVolumesFromSource(t *testing.T) {
+	const source, volume = "rename-volumes-from", "rename-volumes-from-data"
+	t.Cleanup(func() {
+		icmd.RunCommand("docker", "rm", "-f", source, source+"-new")
+		icmd.RunCommand("docker", "volume", "rm", volume)
+	})
+	icmd.RunCommand("docker", "run", "--name", source, "-v", volume+":/data", fixtures.AlpineImage,
+		"sh", "-c", "echo hello > /data/file").Assert(t, icmd.Success)
+
+	result := icmd.RunCommand("docker", "run", "--rm", "--volumes-from", source+":ro", fixtures.AlpineImage, "cat", "/data/file")
+	result.Assert(t, icmd.Expected{Out: "hello"})
+
+	icmd.RunCommand("docker", "rename", source, source+"-new").Assert(t, icmd.Success)
+
+	// --volumes-from resolves the container by its current name.
+	result = icmd.RunCommand("docker", "run", "--rm", "--volumes-from", source+"-new", fixtures.AlpineImage, "cat", "/data/file")
+	result.Assert(t, icmd.Expected{Out: "hello"})
+	result = icmd.RunCommand("docker", "run", "--rm", "--volumes-from", source, fixtures.AlpineImage, "true")
+	result.Assert(t, icmd.Expected{ExitCode: 125, Err: "No such container"})
+}
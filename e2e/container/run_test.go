@@ -1,9 +1,14 @@
 package container
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"os/exec"
+	"strings"
 	"testing"
 
+	"github.com/creack/pty"
 	"github.com/docker/cli/e2e/internal/fixtures"
 	"github.com/docker/cli/internal/test/environment"
 	"gotest.tools/v3/assert"
@@ -149,3 +154,18 @@ func TestRunWithCgroupNamespace(t *testing.T) {
 		"cat", "/sys/fs/cgroup/cgroup.controllers")
 	result.Assert(t, icmd.Success)
 }
+
+// TestRunConsoleSize tests that the size of the terminal is used as the
+// initial console size of a container with a TTY.
+func TestRunConsoleSize(t *testing.T) {
+	cmd := exec.Command("docker", "run", "-i", "-t", "--rm", fixtures.AlpineImage, "stty", "size")
+	p, err := pty.StartWithSize(cmd, &pty.Winsize{Rows: 42, Cols: 123})
+	assert.NilError(t, err, "failed to start container")
+	defer p.Close()
+
+	var out bytes.Buffer
+	// Reading from the pty returns an error once the process exits.
+	_, _ = io.Copy(&out, p)
+	assert.NilError(t, cmd.Wait())
+	assert.Check(t, is.Equal(strings.TrimSpace(out.String()), "42 123"))
+}
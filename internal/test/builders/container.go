@@ -70,6 +70,13 @@ func WithSize(size int64) func(*types.Container) {
 	}
 }
 
+// WithContainerStatus sets the status of the container
+func WithContainerStatus(status string) func(*types.Container) {
+	return func(c *types.Container) {
+		c.Status = status
+	}
+}
+
 // IP sets the ip of the port
 func IP(ip string) func(*types.Port) {
 	return func(p *types.Port) {
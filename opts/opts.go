@@ -289,6 +289,19 @@ func ValidateLabel(value string) (string, error) {
 	return value, nil
 }
 
+// ValidateAnnotation validates that the specified string is a valid annotation,
+// and returns it.
+//
+// Annotations are in the form of key=value; key must be a non-empty string.
+// A value is optional (defaults to an empty string if omitted).
+func ValidateAnnotation(value string) (string, error) {
+	key, _, _ := strings.Cut(value, "=")
+	if strings.TrimSpace(key) == "" {
+		return "", fmt.Errorf("invalid annotation '%s': empty name", value)
+	}
+	return value, nil
+}
+
 // ValidateSysctl validates a sysctl and returns it.
 func ValidateSysctl(val string) (string, error) {
 	validSysctlMap := map[string]bool{
@@ -428,8 +441,14 @@ func ParseLink(val string) (string, string, error) {
 	if strings.HasPrefix(arr[0], "/") {
 		// TODO(thaJeztah): clean up this logic!!
 		_, alias := path.Split(arr[1])
+		if arr[0] == "/" || alias == "" {
+			return "", "", fmt.Errorf("bad format for links: %s", val)
+		}
 		return arr[0][1:], alias, nil
 	}
+	if arr[0] == "" || arr[1] == "" {
+		return "", "", fmt.Errorf("bad format for links: %s", val)
+	}
 	return arr[0], arr[1], nil
 }
 
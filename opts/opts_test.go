@@ -249,6 +249,28 @@ func TestValidateDNSSearch(t *testing.T) {
 	}
 }
 
+func TestValidateAnnotation(t *testing.T) {
+	for _, tc := range []struct {
+		value       string
+		expectedErr string
+	}{
+		{value: "", expectedErr: `invalid annotation '': empty name`},
+		{value: "=value", expectedErr: `invalid annotation '=value': empty name`},
+		{value: " =value", expectedErr: `invalid annotation ' =value': empty name`},
+		{value: "com.example.key=value"},
+		{value: "com.example.key="},
+		{value: "com.example.key"},
+	} {
+		val, err := ValidateAnnotation(tc.value)
+		if tc.expectedErr != "" {
+			assert.Check(t, is.Error(err, tc.expectedErr), tc.value)
+			continue
+		}
+		assert.Check(t, is.Nil(err), tc.value)
+		assert.Check(t, is.Equal(val, tc.value))
+	}
+}
+
 func TestValidateLabel(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -413,10 +435,17 @@ func TestValidateLink(t *testing.T) {
 		"dcdfbe62ecd0:alias",
 		"7a67485460b7642516a4ad82ecefe7f57d0c4916f530561b71a50a3f9c4e33da",
 		"angry_torvalds:linus",
+		"/db1:/app1/mysql",
 	}
 	invalid := map[string]string{
-		"":               "empty string specified for links",
-		"too:much:of:it": "bad format for links: too:much:of:it",
+		"":                "empty string specified for links",
+		"too:much:of:it":  "bad format for links: too:much:of:it",
+		"db1:mysql:extra": "bad format for links: db1:mysql:extra",
+		"db1:":            "bad format for links: db1:",
+		":mysql":          "bad format for links: :mysql",
+		":":               "bad format for links: :",
+		"/db1:/app1/":     "bad format for links: /db1:/app1/",
+		"/:/app1/mysql":   "bad format for links: /:/app1/mysql",
 	}
 
 	for _, link := range valid {